@@ -1,18 +1,19 @@
 package ast
 
 type Assn struct {
-	V Var
-	E Expr
+	V   Var
+	E   Expr
+	Pos Pos // Position of the "="
 }
 
-func NewAssn(v, e Attrib) (Expr, error) {
-	va := v.(Var)
-	ex := e.(Expr)
-	return Assn{V: va, E: ex}, nil
-}
 func (a Assn) Eval(c *Context) Val {
-	newVal := a.E.Eval(c)
-	c.VariableMap[a.V] = newVal
+	newVal := c.eval(a.E)
+	if !c.assign(a.V, newVal) {
+		return ""
+	}
+	if c.Hooks != nil {
+		c.Hooks.Assign(c, a.V, newVal)
+	}
 	return newVal
 }
 func (a Assn) String() string {
@@ -4,8 +4,6 @@ import (
 	"strings"
 )
 
-type Attrib interface{}
-
 type Expr interface {
 	Eval(*Context) Val
 	String() string
@@ -20,12 +18,6 @@ type Program struct {
 	Code  Block
 }
 
-func NewProgram(f, b Attrib) (Expr, error) {
-	funcs := f.([]FuncDecl)
-	code := b.(Block)
-	return Program{Funcs: funcs, Code: code}, nil
-}
-
 // Useful for building ASTs manually
 func EmptyProgram() Program {
 	return Program{Funcs: []FuncDecl{}, Code: []Expr{}}
@@ -34,7 +26,7 @@ func (p Program) Eval(c *Context) Val {
 	for _, f := range p.Funcs {
 		c.UserFunctionMap[f.Identifier] = f
 	}
-	return p.Code.Eval(c)
+	return c.eval(p.Code)
 }
 func (p Program) String() string {
 	funcdecls := make([]string, len(p.Funcs))
@@ -51,19 +43,10 @@ func (p Program) Precedence() int {
 
 type Block []Expr
 
-func NewBlock() (Expr, error) {
-	return Block([]Expr{}), nil
-}
-func BlockPrepend(e, b Attrib) (Expr, error) {
-	block := b.(Block)
-	exp := e.(Expr)
-	block2 := append([]Expr{exp}, block...)
-	return Block(block2), nil
-}
 func (b Block) Eval(c *Context) Val {
 	var last Val
 	for _, exp := range b {
-		last = exp.Eval(c)
+		last = c.eval(exp)
 	}
 	return last
 }
@@ -0,0 +1,23 @@
+package ast
+
+import "errors"
+
+// ErrBadStatement is the error evaluating a Bad statement aborts with
+var ErrBadStatement = errors.New("program contains source code that doesn't parse")
+
+// Bad is a statement that didn't parse, which parsers recovering from syntax errors put in its place
+type Bad struct {
+	Pos Pos // Position of the token the syntax error was found at
+}
+
+func (b Bad) Eval(c *Context) Val {
+	c.abort(ErrBadStatement)
+	return ""
+}
+func (b Bad) String() string {
+	return "<syntax error>"
+}
+func (b Bad) Precedence() int {
+	// Leaf, not operator
+	return LeafPrecedence
+}
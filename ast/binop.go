@@ -35,43 +35,8 @@ func (o Op) String() string {
 type BinOp struct {
 	Lhs Expr
 	Rhs Expr
-	Op Op
-}
-
-func NewOr(a, b Attrib) (Expr, error) {
-	return BinOp{
-		Lhs: a.(Expr),
-		Rhs: b.(Expr),
-		Op:  OrOp,
-	}, nil
-}
-func NewAnd(a, b Attrib) (Expr, error) {
-	return BinOp{
-		Lhs: a.(Expr),
-		Rhs: b.(Expr),
-		Op:  AndOp,
-	}, nil
-}
-func NewNotEquals(a, b Attrib) (Expr, error) {
-	return BinOp{
-		Lhs: a.(Expr),
-		Rhs: b.(Expr),
-		Op:  NotEqualsOp,
-	}, nil
-}
-func NewEquals(a, b Attrib) (Expr, error) {
-	return BinOp{
-		Lhs: a.(Expr),
-		Rhs: b.(Expr),
-		Op:  EqualsOp,
-	}, nil
-}
-func NewConcat(a, b Attrib) (Expr, error) {
-	return BinOp{
-		Lhs: a.(Expr),
-		Rhs: b.(Expr),
-		Op:  ConcatOp,
-	}, nil
+	Op  Op
+	Pos Pos // Position of the operator
 }
 
 func (b BinOp) Eval(c *Context) Val {
@@ -120,16 +85,15 @@ func (b BinOp) Precedence() int {
 	return int(b.Op)
 }
 
-
 func (b BinOp) evalOr(c *Context) Val {
-	if BoolOf(b.Lhs.Eval(c)) || BoolOf(b.Rhs.Eval(c)) {
+	if BoolOf(c.eval(b.Lhs)) || BoolOf(c.eval(b.Rhs)) {
 		return Val("true")
 	} else {
 		return Val("false")
 	}
 }
 func (b BinOp) evalAnd(c *Context) Val {
-	if BoolOf(b.Lhs.Eval(c)) && BoolOf(b.Rhs.Eval(c)) {
+	if BoolOf(c.eval(b.Lhs)) && BoolOf(c.eval(b.Rhs)) {
 		return Val("true")
 	} else {
 		return Val("false")
@@ -137,18 +101,40 @@ func (b BinOp) evalAnd(c *Context) Val {
 }
 func (b BinOp) evalNotEquals(c *Context) Val {
 	val := "false"
-	if b.Lhs.Eval(c) != b.Rhs.Eval(c) {
+	lhs, rhs, ok := b.evalOperands(c)
+	if ok && lhs != rhs {
 		val = "true"
 	}
 	return Val(val)
 }
 func (b BinOp) evalEquals(c *Context) Val {
 	val := "false"
-	if b.Lhs.Eval(c) == b.Rhs.Eval(c) {
+	lhs, rhs, ok := b.evalOperands(c)
+	if ok && lhs == rhs {
 		val = "true"
 	}
 	return Val(val)
 }
 func (b BinOp) evalConcat(c *Context) Val {
-	return b.Lhs.Eval(c) + b.Rhs.Eval(c)
+	lhs, rhs, ok := b.evalOperands(c)
+	// Check whether the result fits before actually allocating it
+	if !ok || !c.fits(int64(len(lhs)+len(rhs))) {
+		return ""
+	}
+	return lhs + rhs
+}
+
+// evalOperands evaluates both operands, charging for the Lhs while the Rhs is being evaluated
+func (b BinOp) evalOperands(c *Context) (lhs, rhs Val, ok bool) {
+	lhs = c.eval(b.Lhs)
+	if !c.hold(lhs) {
+		return "", "", false
+	}
+	defer c.unhold(lhs)
+	rhs = c.eval(b.Rhs)
+	if !c.hold(rhs) {
+		return "", "", false
+	}
+	defer c.unhold(rhs)
+	return lhs, rhs, true
 }
@@ -0,0 +1,171 @@
+package ast
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// Builtin is a function implemented in Go which StringLang programs can call
+type Builtin interface {
+	// Name is the identifier programs use to call the function
+	Name() string
+	// MinArity is the minimum number of arguments the function accepts
+	MinArity() int
+	// MaxArity is the maximum number of arguments the function accepts, negative if there is none
+	MaxArity() int
+	// Pure reports whether the result only depends on the arguments and calling the function has no side effects,
+	// which allows analyses and optimizations to treat calls like any other expression
+	Pure() bool
+	// Doc is a human-readable description of the function
+	Doc() string
+	// Call calls the function, a non-nil error aborts the evaluation with a RuntimeError
+	Call(cc *CallContext, args []string) (string, error)
+}
+
+// CallContext is the view a Builtin gets of the evaluation calling it
+type CallContext struct {
+	// The context.Context of the evaluation, it is done as soon as the evaluation gets cancelled
+	context.Context
+	frame *Context
+}
+
+// Limits returns the limits of the calling evaluation
+func (cc *CallContext) Limits() Limits {
+	return Limits{MaxMemory: cc.frame.memoryLimit(), MaxCallDepth: cc.frame.MaxCallDepth}
+}
+
+// MemoryUsage returns the number of bytes the calling evaluation is currently using
+func (cc *CallContext) MemoryUsage() int64 {
+	return cc.frame.MemoryUsage()
+}
+
+// RuntimeError is an error that aborted an evaluation, e.g. returned by a Builtin
+type RuntimeError struct {
+	Func string // The function that failed
+	Pos  Pos    // Position of the failing call, if known
+	Err  error
+}
+
+func (e *RuntimeError) Error() string {
+	return e.Func + ": " + e.Err.Error()
+}
+
+func (e *RuntimeError) Unwrap() error {
+	return e.Err
+}
+
+// BuiltinFunc is a Builtin implemented by a Go function
+type BuiltinFunc struct {
+	Identifier    string
+	Min           int
+	Max           int // Negative if there is no maximum
+	IsPure        bool
+	Documentation string
+	Fn            func(cc *CallContext, args []string) (string, error)
+}
+
+func (b BuiltinFunc) Name() string {
+	return b.Identifier
+}
+func (b BuiltinFunc) MinArity() int {
+	return b.Min
+}
+func (b BuiltinFunc) MaxArity() int {
+	return b.Max
+}
+func (b BuiltinFunc) Pure() bool {
+	return b.IsPure
+}
+func (b BuiltinFunc) Doc() string {
+	return b.Documentation
+}
+func (b BuiltinFunc) Call(cc *CallContext, args []string) (string, error) {
+	return b.Fn(cc, args)
+}
+
+// PlainBuiltin adapts a plain Go function to a Builtin accepting any number of arguments. Because nothing is known
+// about fn, it is not pure.
+func PlainBuiltin(name string, fn func([]string) string) Builtin {
+	return BuiltinFunc{
+		Identifier: name,
+		Min:        0,
+		Max:        -1,
+		Fn: func(_ *CallContext, args []string) (string, error) {
+			return fn(args), nil
+		},
+	}
+}
+
+// PlainBuiltins adapts all functions of funcs using PlainBuiltin, keyed by their name
+func PlainBuiltins(funcs map[string]func([]string) string) map[string]Builtin {
+	builtins := make(map[string]Builtin, len(funcs))
+	for name, fn := range funcs {
+		builtins[name] = PlainBuiltin(name, fn)
+	}
+	return builtins
+}
+
+// AddBuiltin makes b available to programs using c under its name
+func (c *Context) AddBuiltin(b Builtin) {
+	if c.FunctionMap == nil {
+		c.FunctionMap = make(map[string]Builtin)
+	}
+	c.FunctionMap[b.Name()] = b
+}
+
+// Builtins returns the built-in functions of c sorted by name
+func (c *Context) Builtins() []Builtin {
+	names := make([]string, 0, len(c.FunctionMap))
+	for name := range c.FunctionMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	builtins := make([]Builtin, len(names))
+	for i, name := range names {
+		builtins[i] = c.FunctionMap[name]
+	}
+	return builtins
+}
+
+// PureFuncs returns the names of the pure built-in functions of c that aren't shadowed by user-defined functions
+func (c *Context) PureFuncs() Set {
+	pure := make(Set)
+	for name, b := range c.FunctionMap {
+		if _, shadowed := c.UserFunctionMap[name]; !shadowed && b.Pure() {
+			pure.Add(name)
+		}
+	}
+	return pure
+}
+
+// callBuiltin checks the arity of the call and calls b from frame c
+func callBuiltin(c *Context, b Builtin, args []string) (string, error) {
+	if len(args) < b.MinArity() || (b.MaxArity() >= 0 && len(args) > b.MaxArity()) {
+		return "", fmt.Errorf("expected %s, got %d", Arity(b), len(args))
+	}
+	return callRecorded(c, &CallContext{Context: c.GoContext(), frame: c}, b, args)
+}
+
+// Arity describes the number of arguments b accepts, e.g. "1 argument" or "1 to 2 arguments"
+func Arity(b Builtin) string {
+	min, max := b.MinArity(), b.MaxArity()
+	switch {
+	case max < 0:
+		return "at least " + Arguments(min)
+	case min == max:
+		return Arguments(min)
+	default:
+		return fmt.Sprintf("%d to %d arguments", min, max)
+	}
+}
+
+// Arguments describes n arguments, e.g. "1 argument" or "2 arguments"
+func Arguments(n int) string {
+	if n == 1 {
+		return "1 argument"
+	}
+	return strconv.Itoa(n) + " arguments"
+}
@@ -1,86 +1,146 @@
 package ast
 
 import (
-	"fmt"
+	"errors"
 	"strings"
 )
 
 type Call struct {
 	Fn   Expr
 	Args CallArgs
+	Pos  Pos // Position of the "(" of the arguments
 }
 
-func NewCall(f, as Attrib) (Expr, error) {
-	fn := f.(Expr)
-	args := as.(CallArgs)
-	return Call{Fn: fn, Args: args}, nil
-}
 func (ca Call) Eval(c *Context) Val {
-	if checkExit(c) {
+	if checkExit(c) || !c.enterCall() {
 		return ""
 	}
+	defer c.leaveCall()
 
 	if fnVar, ok := ca.Fn.(Var); ok {
 		userFn, ok := c.UserFunctionMap[string(fnVar)]
 		if ok {
-			vals := make([]Val, 0, len(ca.Args))
-			for _, argExp := range ca.Args {
-				v := argExp.Eval(c)
-				vals = append(vals, v)
+			vals, ok := ca.evalArgs(c)
+			if !ok {
+				return ""
+			}
+			// The arguments are charged again as the variables of the new frame
+			c.unholdAll(vals)
+			if c.Hooks != nil {
+				c.Hooks.CallFunc(c, UserCall, userFn.Identifier, vals)
 			}
 			res := userFn.Call(c, vals)
+			if c.Hooks != nil {
+				c.Hooks.ReturnFunc(c, UserCall, userFn.Identifier, res)
+			}
 			return res
 		}
 
 		fn, ok := c.FunctionMap[string(fnVar)]
 		if ok {
-			vals := make([]string, 0, len(ca.Args))
-			for _, argExp := range ca.Args {
-				v := argExp.Eval(c)
-				vals = append(vals, string(v))
+			// The policy names built-in functions the way programs call them, which may differ from fn.Name()
+			if err := c.Policy.checkBuiltin(string(fnVar)); err != nil {
+				c.abort(err)
+				return ""
+			}
+			vals, ok := ca.evalArgs(c)
+			if !ok {
+				return ""
+			}
+			defer c.unholdAll(vals)
+			if c.Hooks != nil {
+				c.Hooks.CallFunc(c, BuiltinCall, fn.Name(), vals)
+			}
+			res, err := callBuiltin(c, fn, valsToStrings(vals))
+			if c.Hooks != nil {
+				c.Hooks.ReturnFunc(c, BuiltinCall, fn.Name(), Val(res))
+			}
+			if err != nil {
+				c.abort(&RuntimeError{Func: fn.Name(), Pos: ca.Pos, Err: err})
+				return ""
+			}
+			if !c.fits(int64(len(res))) {
+				return ""
 			}
-			res := fn(vals)
 			return Val(res)
 		}
 		// Treat as expression, fallthrough
 	}
 
-	fnSource := ca.Fn.Eval(c)
-	fnAst, err := c.parseFn([]byte(fnSource))
+	if err := c.Policy.checkDynamicCall(); err != nil {
+		c.abort(err)
+		return ""
+	}
+	fnSource := c.eval(ca.Fn)
+	vals, ok := ca.evalArgs(c)
+	if !ok {
+		return ""
+	}
+	c.unholdAll(vals)
+	lam, err := c.parseLambda(fnSource)
 	if err != nil {
-		fmt.Println("Error occurred:", err)
-		fmt.Println("Fn is:")
-		fmt.Println(ca.Fn)
-		fmt.Println("source is:")
-		fmt.Println(string(fnSource))
+		// Like other invalid operations, calling a value which isn't a lambda, e.g. of an undefined function, results
+		// in "", though the arguments are evaluated like those of any call
 		return ""
 	}
+
+	if c.Hooks != nil {
+		c.Hooks.CallFunc(c, LambdaCall, ca.Fn.String(), vals)
+	}
+	res := lam.Call(c, vals)
+	if c.Hooks != nil {
+		c.Hooks.ReturnFunc(c, LambdaCall, ca.Fn.String(), res)
+	}
+	return res
+}
+
+// parseLambda parses the source of a lambda, i.e. the value of a lambda expression
+func (c *Context) parseLambda(src Val) (Lambda, error) {
+	if lam, ok := c.lambdas[src]; ok {
+		return lam, nil
+	}
+	fnAst, err := c.parseFn([]byte(src))
+	if err != nil {
+		return Lambda{}, err
+	}
 	fnProg := fnAst.(Program)
-	if len(fnProg.Code) != 1 {
+	if len(fnProg.Funcs) != 0 || len(fnProg.Code) != 1 {
 		// Must consist of exactly one lambda
-		fmt.Println("Length of parsed program is not equal to 1, Fn expression is:")
-		fmt.Println(ca.Fn)
-		fmt.Println("source is:")
-		fmt.Println(string(fnSource))
-		return ""
+		return Lambda{}, errors.New("source of a lambda must consist of exactly one lambda")
 	}
-	fst := fnProg.Code[0]
-	lam, ok := fst.(Lambda)
+	lam, ok := fnProg.Code[0].(Lambda)
 	if !ok {
-		// Must be lambda
-		fmt.Println("Parsed program is not a Lambda:")
-		fmt.Println(string(fnSource))
-		return ""
+		return Lambda{}, errors.New("source of a lambda is not a lambda")
 	}
+	return lam, nil
+}
 
+// evalArgs evaluates the arguments of the call, which stay charged to c until released using unholdAll
+func (ca Call) evalArgs(c *Context) ([]Val, bool) {
 	vals := make([]Val, 0, len(ca.Args))
 	for _, argExp := range ca.Args {
-		v := argExp.Eval(c)
+		v := c.eval(argExp)
+		if !c.hold(v) {
+			c.unholdAll(vals)
+			return nil, false
+		}
 		vals = append(vals, v)
 	}
+	return vals, true
+}
 
-	res := lam.Call(c, vals)
-	return res
+func (c *Context) unholdAll(vals []Val) {
+	for _, v := range vals {
+		c.unhold(v)
+	}
+}
+
+func valsToStrings(vals []Val) []string {
+	strs := make([]string, len(vals))
+	for i, v := range vals {
+		strs[i] = string(v)
+	}
+	return strs
 }
 func (ca Call) String() string {
 	args := make([]string, 0, len(ca.Args))
@@ -103,32 +163,15 @@ func (ca Call) Precedence() int {
 // CallArgs is not an Expr, since it can never appear on its own
 type CallArgs []Expr
 
-func NewCallArgs() (CallArgs, error) {
-	return []Expr{}, nil
-}
-func CallArgsPrepend(e, a Attrib) (CallArgs, error) {
-	args := a.(CallArgs)
-	exp := e.(Expr)
-	args2 := append([]Expr{exp}, args...)
-	return args2, nil
-}
-
 // FuncDecl is not an Expr, since it can never appear on its own
 type FuncDecl struct {
 	Params     []string
 	Code       Block
 	Identifier string
+	Doc        string // The comment directly preceding the declaration, if any
+	Pos        Pos    // Position of the "fun" keyword
 }
 
-func NewFuncDecl(i, p, b Attrib) (FuncDecl, error) {
-	id := attribToString(i)
-	params := p.([]string)
-	code := b.(Block)
-	return FuncDecl{Params: params, Code: code, Identifier: id}, nil
-}
-
-const GoStackframeEstimate = 8 * 1024
-
 func (f FuncDecl) Call(c *Context, args []Val) Val {
 	newVars := make(map[Var]Val)
 	for i, p := range f.Params {
@@ -138,17 +181,19 @@ func (f FuncDecl) Call(c *Context, args []Val) Val {
 		}
 		newVars[Var(p)] = argVal
 	}
-	cNew := Context{
-		VariableMap:     newVars,
-		UserFunctionMap: c.UserFunctionMap,
-		FunctionMap:     c.FunctionMap,
-		Args:            c.Args,
-		MaxStackSize:    c.MaxStackSize - CheckSize(c.VariableMap) - GoStackframeEstimate, // New context needs to account for Go stackframes
-		limitStackSize:  c.limitStackSize,
-		exitChannel:     c.exitChannel,
-		parseFn:         c.parseFn,
-	}
-	return f.Code.Eval(&cNew)
+	// The frame and its variables are charged until the call returns
+	frameSize := GoStackframeEstimate + CheckSize(newVars)
+	if !c.charge(frameSize) {
+		return ""
+	}
+	// The new frame shares everything but the variables with c, including the state of the evaluation
+	c.state()
+	cNew := *c
+	cNew.VariableMap = newVars
+	res := cNew.eval(f.Code)
+	// Assignments in the frame were charged as well, hence release all variables it holds now
+	c.release(GoStackframeEstimate + CheckSize(cNew.VariableMap))
+	return res
 }
 func (f FuncDecl) String() string {
 	var id = f.Identifier
@@ -157,13 +202,3 @@ func (f FuncDecl) String() string {
 	codeStr := strings.Join(codeLines, "\n\t")
 	return "fun " + id + "(" + args + ") {\n\t" + codeStr + "\n}"
 }
-func FuncDeclsAppend(f, fs Attrib) ([]FuncDecl, error) {
-	fdecl := f.(FuncDecl)
-	funcs := fs.([]FuncDecl)
-	return append(funcs, fdecl), nil
-}
-func FuncParamsPrepend(p, ps Attrib) ([]string, error) {
-	param := attribToString(p)
-	params := ps.([]string)
-	return append([]string{param}, params...), nil
-}
@@ -0,0 +1,151 @@
+package ast
+
+import "strconv"
+
+// Canonical returns e with the parameters and local variables of every lambda in it renamed to "v0", "v1", ... in the
+// order they first occur. Lambdas which only differ in these names, i.e. are alpha-equivalent, are Equal once
+// canonical, see AlphaEqual.
+//
+// Variables a lambda reads before assigning them are captured from the scope it is evaluated in, hence keep their
+// names, as do the names it calls, which refer to functions before variables. Variables only referred to by source
+// code evaluated at runtime, e.g. by eval, aren't recognized as such.
+func Canonical(e Expr) Expr {
+	return rename(e, nil)
+}
+
+// CanonicalFunc returns f with its parameters and variables renamed like those of lambdas by Canonical. Functions
+// don't see the variables of their callers, hence all of them are renamed but the names f calls.
+func CanonicalFunc(f FuncDecl) FuncDecl {
+	fixed := make(map[string]string)
+	for name := range calledNames(f.Code) {
+		fixed[name] = name
+	}
+	f.Params, f.Code = canonicalScope(f.Params, f.Code, fixed)
+	return f
+}
+
+// AlphaEqual returns whether a and b are Equal up to the names of the parameters and local variables of lambdas,
+// see Canonical
+func AlphaEqual(a, b Expr) bool {
+	return Equal(Canonical(a), Canonical(b))
+}
+
+// AlphaEqualFuncs returns whether a and b are EqualFuncs up to the names of their parameters and variables, see
+// CanonicalFunc
+func AlphaEqualFuncs(a, b FuncDecl) bool {
+	return EqualFuncs(CanonicalFunc(a), CanonicalFunc(b))
+}
+
+// canonicalLambda canonicalizes l, within a scope whose variables are renamed according to outer
+func canonicalLambda(l Lambda, outer map[string]string) Lambda {
+	fixed := make(map[string]string)
+	for name := range UsedBeforeDefVars(l, EmptySet()) {
+		fixed[name] = name
+		if renamed, ok := outer[name]; ok {
+			fixed[name] = renamed
+		}
+	}
+	for name := range calledNames(l.Code) {
+		fixed[name] = name
+	}
+	l.Params, l.Code = canonicalScope(l.Params, l.Code, fixed)
+	return l
+}
+
+// canonicalScope renames the parameters and variables of a function or lambda to canonical names, but those in
+// fixed, which map to the names they keep
+func canonicalScope(params []string, code Block, fixed map[string]string) ([]string, Block) {
+	taken := make(Set, len(fixed))
+	for _, name := range fixed {
+		taken.Add(name)
+	}
+	names := make(map[string]string, len(fixed))
+	for name, renamed := range fixed {
+		names[name] = renamed
+	}
+	next := 0
+	add := func(name string) {
+		if _, ok := names[name]; ok {
+			return
+		}
+		for taken.Contains("v" + strconv.Itoa(next)) {
+			next++
+		}
+		names[name] = "v" + strconv.Itoa(next)
+		next++
+	}
+
+	for _, p := range params {
+		add(p)
+	}
+	varsInOrder(code, add)
+
+	newParams := make([]string, len(params))
+	for i, p := range params {
+		newParams[i] = names[p]
+	}
+	return newParams, rename(code, names).(Block)
+}
+
+// varsInOrder calls add for every variable read or assigned in e, in the order of their occurrence. The variables of
+// lambdas within e are their own.
+func varsInOrder(e Expr, add func(string)) {
+	Inspect(e, func(e Expr) bool {
+		switch e := e.(type) {
+		case Assn:
+			add(string(e.V))
+		case Var:
+			add(string(e))
+		case Lambda:
+			return false
+		}
+		return true
+	})
+}
+
+// calledNames returns the names called in e, including within lambdas
+func calledNames(e Expr) Set {
+	names := make(Set)
+	Inspect(e, func(e Expr) bool {
+		if call, ok := e.(Call); ok {
+			if v, ok := call.Fn.(Var); ok {
+				names.Add(string(v))
+			}
+		}
+		return true
+	})
+	return names
+}
+
+// rename returns e with its variables renamed according to names and its lambdas canonicalized
+func rename(e Expr, names map[string]string) Expr {
+	if prog, ok := e.(Program); ok {
+		funcs := make([]FuncDecl, len(prog.Funcs))
+		for i := range prog.Funcs {
+			funcs[i] = CanonicalFunc(prog.Funcs[i])
+		}
+		return Program{Funcs: funcs, Code: rename(prog.Code, names).(Block)}
+	}
+	return Rewrite(e, func(c *Cursor) Expr {
+		for _, p := range c.Path {
+			if _, ok := p.(Lambda); ok {
+				// Left to canonicalLambda of the outermost lambda
+				return c.Expr
+			}
+		}
+		switch e := c.Expr.(type) {
+		case Assn:
+			if renamed, ok := names[string(e.V)]; ok {
+				e.V = Var(renamed)
+			}
+			return e
+		case Var:
+			if renamed, ok := names[string(e)]; ok {
+				return Var(renamed)
+			}
+		case Lambda:
+			return canonicalLambda(e, names)
+		}
+		return c.Expr
+	})
+}
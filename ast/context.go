@@ -1,37 +1,136 @@
 package ast
 
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+var (
+	// ErrExternalExit is reported when the evaluation was stopped using the exit channel
+	ErrExternalExit = errors.New("program was stopped externally")
+	// ErrMemoryLimitExceeded is reported when the program tries to use more than MaxMemory bytes
+	ErrMemoryLimitExceeded = errors.New("program exceeded its memory limit")
+	// ErrRecursionLimit is reported when calls are nested deeper than MaxCallDepth
+	ErrRecursionLimit = errors.New("program exceeded the maximum call depth")
+)
+
+// DefaultMaxCallDepth is the call depth new contexts are limited to. Every call nests multiple Go calls of the
+// interpreter, hence programs recursing without bound would otherwise crash the host with a fatal stack overflow.
+const DefaultMaxCallDepth = 1000
+
+// NewContext returns a Context with the given arguments and plain built-in functions, see PlainBuiltin. parseFn parses
+// source code evaluated at runtime, e.g. the source of lambdas.
 func NewContext(args []string, funcs map[string]func([]string) string, parseFn func([]byte) (Expr, error)) *Context {
+	return NewContextBuiltins(args, PlainBuiltins(funcs), parseFn)
+}
+
+// NewContextBuiltins returns a Context with the given arguments and built-in functions, keyed by their name, see
+// NewContext
+func NewContextBuiltins(args []string, builtins map[string]Builtin, parseFn func([]byte) (Expr, error)) *Context {
+	if builtins == nil {
+		builtins = make(map[string]Builtin)
+	}
+	seed := time.Now().UnixNano()
 	return &Context{
 		Args:            args,
 		VariableMap:     make(map[Var]Val),
 		UserFunctionMap: make(map[string]FuncDecl),
-		FunctionMap:     funcs,
+		FunctionMap:     builtins,
+		MaxMemory:       -1,
 		MaxStackSize:    -1,
-		limitStackSize:  false,
+		MaxCallDepth:    DefaultMaxCallDepth,
 		exitChannel:     make(chan int, 1),
 		parseFn:         parseFn,
+		run:             newRunState(context.Background(), 0),
+		seed:            seed,
+		rng:             rand.New(rand.NewSource(seed)),
 	}
 }
 
 type Context struct {
 	Args            []string
 	VariableMap     map[Var]Val
-	FunctionMap     map[string]func([]string) string
+	FunctionMap     map[string]Builtin // Built-in functions, keyed by their name
 	UserFunctionMap map[string]FuncDecl
-	MaxStackSize    int64
+	MaxMemory       int64   // Maximum number of bytes an evaluation may use, negative for no limit
+	MaxCallDepth    int     // Maximum number of nested calls, negative for no limit
+	Policy          *Policy // Restrictions for untrusted programs, nil for none
+	Hooks           Hooks   // Observers of the evaluation, nil for none
 	exitChannel     chan int
-	limitStackSize  bool
 	parseFn         func([]byte) (Expr, error) /* Ugly hack to avoid illegal circular imports */
+	run             *runState                  // Shared by all frames of the current evaluation
+	seed            int64
+	rng             *rand.Rand
+	clock           func() time.Time // nil for time.Now
+	recording       *Recording       // Nondeterministic calls are appended to it if non-nil, see replay.go
+	replay          *replayState     // Nondeterministic calls are replayed from it if non-nil
+	lambdas         map[Val]Lambda   // Lambdas by their source while hooks are set, see Lambda.Eval
+	canonical       bool             // Whether lambdas evaluate to their canonical source, see SetCanonicalLambdas
+
+	// MaxStackSize limits memory like MaxMemory, if both aren't negative the smaller limit applies.
+	//
+	// Deprecated: Use MaxMemory instead.
+	MaxStackSize int64
+}
+
+// runState is the state of a single evaluation, shared by all of its frames
+type runState struct {
+	ctx    context.Context
+	err    error // The reason the evaluation was aborted, sticky once set
+	mem    int64 // Bytes currently in use by all frames, see memory.go
+	depth  int   // Number of calls currently being evaluated
+	active bool  // Whether EvalContext is currently evaluating using this state
+}
+
+func newRunState(ctx context.Context, mem int64) *runState {
+	return &runState{ctx: ctx, mem: mem}
+}
+
+// Limits are the resource limits of an evaluation. Zero fields keep the defaults of NewContext, negative fields
+// disable the corresponding limit.
+type Limits struct {
+	MaxMemory    int64 // See SetMaxMemory
+	MaxCallDepth int   // See SetMaxCallDepth
+}
+
+// SetLimits sets all non-zero limits of l
+func (c *Context) SetLimits(l Limits) {
+	if l.MaxMemory != 0 {
+		c.SetMaxMemory(l.MaxMemory)
+	}
+	if l.MaxCallDepth != 0 {
+		c.SetMaxCallDepth(l.MaxCallDepth)
+	}
 }
 
-func (c *Context) SetMaxStackSize(sz int64) {
-	c.MaxStackSize = sz
-	c.limitStackSize = true
-	if sz < 0 {
-		c.limitStackSize = false
+// SetMaxCallDepth sets the maximum number of nested calls of user-defined functions, lambdas and built-in functions
+// (e.g. eval), a negative depth disables the limit
+func (c *Context) SetMaxCallDepth(depth int) {
+	c.MaxCallDepth = depth
+}
+
+// enterCall accounts for a new nested call, it returns false and aborts the evaluation with ErrRecursionLimit if
+// that exceeds MaxCallDepth. Every successful enterCall must be followed by leaveCall.
+func (c *Context) enterCall() bool {
+	s := c.state()
+	if c.MaxCallDepth >= 0 && s.depth >= c.MaxCallDepth {
+		c.abort(ErrRecursionLimit)
+		return false
 	}
+	s.depth++
+	return true
+}
+
+func (c *Context) leaveCall() {
+	c.state().depth--
 }
 
+// GetExitChannel returns a channel which stops the evaluation when sent to.
+//
+// Deprecated: Use EvalContext with a cancellable context.Context instead.
 func (c *Context) GetExitChannel() chan int {
 	if c.exitChannel == nil {
 		c.exitChannel = make(chan int, 1)
@@ -39,8 +138,71 @@ func (c *Context) GetExitChannel() chan int {
 	return c.exitChannel
 }
 
+// EvalContext evaluates e using c and stops as soon as ctx is done. Evaluation happens on the calling goroutine,
+// cancellation is observed between steps of the program, hence no goroutines are left behind.
+func (c *Context) EvalContext(ctx context.Context, e Expr) (res Val, err error) {
+	if c.run != nil && c.run.active {
+		// Called during an evaluation, e.g. by a built-in function calling back into StringLang. The nested
+		// evaluation is part of the running one, hence shares its cancellation, limits and errors.
+		return c.eval(e), c.Err()
+	}
+
+	if prog, ok := e.(Program); ok {
+		if err := c.CheckPolicy(prog); err != nil {
+			return "", err
+		}
+	}
+
+	// Variables surviving from previous evaluations (e.g. in the REPL) still take up memory
+	c.run = newRunState(ctx, CheckSize(c.VariableMap))
+	c.run.active = true
+	defer func() {
+		c.run.active = false
+		if r := recover(); r != nil {
+			if h, ok := c.Hooks.(PanicHooks); ok {
+				h.Panicked(c, r)
+			}
+			res, err = "", fmt.Errorf("%v", r)
+		}
+	}()
+
+	res = c.eval(e)
+	if err = c.Err(); err != nil {
+		return "", err
+	}
+	if err = c.Policy.checkOutput(res); err != nil {
+		return "", err
+	}
+	return res, nil
+}
+
+// GoContext returns the context.Context of the current evaluation
+func (c *Context) GoContext() context.Context {
+	return c.state().ctx
+}
+
+// Err returns the reason the current evaluation was aborted, or nil if it wasn't
+func (c *Context) Err() error {
+	return c.state().err
+}
+
+// abort stops the current evaluation with err, the first reason wins
+func (c *Context) abort(err error) {
+	s := c.state()
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+func (c *Context) state() *runState {
+	if c.run == nil {
+		c.run = newRunState(context.Background(), CheckSize(c.VariableMap))
+	}
+	return c.run
+}
+
 func (c *Context) FuncNames() Set {
-	names := make(Set, len(c.FunctionMap) + len(c.UserFunctionMap))
+	names := make(Set, len(c.FunctionMap)+len(c.UserFunctionMap))
 	for fId := range c.UserFunctionMap {
 		names.Add(fId)
 	}
@@ -0,0 +1,112 @@
+package ast_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+// loopSource never terminates on its own
+const loopSource = `x = ""; while ("true") { x = "a" }`
+
+func TestEvalContextCancel(t *testing.T) {
+	e := parse(t, loopSource)
+	goroutines := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	_, err := stringlang.NewContext(nil, nil).EvalContext(ctx, e)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	// The evaluation ran on this goroutine, hence none may be left once it returned
+	time.Sleep(10 * time.Millisecond)
+	if n := runtime.NumGoroutine(); n > goroutines {
+		t.Errorf("%d goroutines after evaluating, %d before", n, goroutines)
+	}
+}
+
+func TestEvalContextDeadline(t *testing.T) {
+	e := parse(t, loopSource)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := stringlang.NewContext(nil, nil).EvalContext(ctx, e)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The context stays usable for further evaluations
+	c := stringlang.NewContext(nil, nil)
+	c.EvalContext(ctx, e)
+	res, err := c.EvalContext(context.Background(), parse(t, `"done"`))
+	if err != nil || res != "done" {
+		t.Errorf("evaluating after the deadline: got %q, %v, want %q", res, err, "done")
+	}
+}
+
+func TestEvalContextExternalExit(t *testing.T) {
+	e := parse(t, loopSource)
+	c := stringlang.NewContext(nil, nil)
+	c.GetExitChannel() <- ast.SigExternalExit
+	_, err := c.EvalContext(context.Background(), e)
+	if err != ast.ErrExternalExit {
+		t.Errorf("got error %v, want %v", err, ast.ErrExternalExit)
+	}
+}
+
+func TestEvalContextPanic(t *testing.T) {
+	c := stringlang.NewContext(nil, map[string]func([]string) string{
+		"boom": func([]string) string { panic("boom") },
+	})
+	res, err := c.EvalContext(context.Background(), parse(t, `"a" + boom()`))
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("got %q, %v, want an error mentioning the panic", res, err)
+	}
+	res, err = c.EvalContext(context.Background(), parse(t, `"still" + " usable"`))
+	if err != nil || res != "still usable" {
+		t.Errorf("evaluating after a panic: got %q, %v", res, err)
+	}
+}
+
+func TestMaxCallDepth(t *testing.T) {
+	const recursive = `fun f(n) { if (n == "xxxxx") { n } else { f(n + "x") } } f("")`
+	// Three nested calls of eval
+	evals := `"1"`
+	for i := 0; i < 3; i++ {
+		evals = "eval(" + strconv.Quote(evals) + ")"
+	}
+	tests := []struct {
+		name     string
+		src      string
+		maxDepth int
+		exceeds  bool
+	}{
+		{"functions", recursive, 5, true},
+		{"functions within limit", recursive, 6, false},
+		{"no limit", recursive, -1, false},
+		{"lambdas", `h = fun() { "" }; k = fun() { h() }; l = fun() { k() }; l()`, 2, true},
+		{"lambdas within limit", `h = fun() { "" }; k = fun() { h() }; l = fun() { k() }; l()`, 3, false},
+		{"eval", evals, 2, true},
+		{"eval within limit", evals, 3, false},
+		{"unbounded recursion", `fun f() { f() } f()`, 0, true},
+	}
+	for _, tt := range tests {
+		c := stringlang.NewContextBuiltins(nil, ast.EvalBuiltin())
+		if tt.maxDepth != 0 {
+			c.SetMaxCallDepth(tt.maxDepth)
+		}
+		_, err := c.EvalContext(context.Background(), parse(t, tt.src))
+		if tt.exceeds && err != ast.ErrRecursionLimit {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, ast.ErrRecursionLimit)
+		}
+		if !tt.exceeds && err != nil {
+			t.Errorf("%s: got error %v", tt.name, err)
+		}
+	}
+}
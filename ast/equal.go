@@ -0,0 +1,203 @@
+package ast
+
+import (
+	"encoding/binary"
+	"hash"
+	"hash/fnv"
+)
+
+// Equal returns whether a and b are the same expression, ignoring positions and doc comments. Unlike comparing their
+// source code, it tells apart expressions which only print the same, e.g. a Val and a Block containing it.
+func Equal(a, b Expr) bool {
+	switch a := a.(type) {
+	case Program:
+		b, ok := b.(Program)
+		if !ok || len(a.Funcs) != len(b.Funcs) {
+			return false
+		}
+		for i := range a.Funcs {
+			if !EqualFuncs(a.Funcs[i], b.Funcs[i]) {
+				return false
+			}
+		}
+		return Equal(a.Code, b.Code)
+	case Block:
+		b, ok := b.(Block)
+		return ok && equalExprs(a, b)
+	case Assn:
+		b, ok := b.(Assn)
+		return ok && a.V == b.V && Equal(a.E, b.E)
+	case BinOp:
+		b, ok := b.(BinOp)
+		return ok && a.Op == b.Op && Equal(a.Lhs, b.Lhs) && Equal(a.Rhs, b.Rhs)
+	case Call:
+		b, ok := b.(Call)
+		return ok && Equal(a.Fn, b.Fn) && equalExprs(a.Args, b.Args)
+	case IfElse:
+		b, ok := b.(IfElse)
+		return ok && Equal(a.Cond, b.Cond) && Equal(a.Then, b.Then) && Equal(a.Else, b.Else)
+	case Index:
+		b, ok := b.(Index)
+		return ok && Equal(a.Source, b.Source) && Equal(a.I, b.I)
+	case Lambda:
+		b, ok := b.(Lambda)
+		return ok && equalStrings(a.Params, b.Params) && Equal(a.Code, b.Code)
+	case While:
+		b, ok := b.(While)
+		return ok && Equal(a.Cond, b.Cond) && Equal(a.Body, b.Body)
+	case Bad:
+		_, ok := b.(Bad)
+		return ok
+	case Var, Val, Arg:
+		return a == b
+	}
+	return false
+}
+
+// EqualFuncs returns whether a and b are the same function declaration, ignoring positions and doc comments
+func EqualFuncs(a, b FuncDecl) bool {
+	return a.Identifier == b.Identifier && equalStrings(a.Params, b.Params) && Equal(a.Code, b.Code)
+}
+
+func equalExprs(a, b []Expr) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of e, which is the same for expressions Equal to it. It only depends on the structure of e,
+// hence it is stable across runs and may be stored, e.g. to find duplicates among stored programs.
+func Hash(e Expr) uint64 {
+	h := &hasher{h: fnv.New64a()}
+	h.expr(e)
+	return h.h.Sum64()
+}
+
+// HashFunc returns a hash of f, which is the same for function declarations EqualFuncs to it, see Hash
+func HashFunc(f FuncDecl) uint64 {
+	h := &hasher{h: fnv.New64a()}
+	h.fn(f)
+	return h.h.Sum64()
+}
+
+// hasher hashes an unambiguous encoding of expressions: a tag per node followed by its fields
+type hasher struct {
+	h   hash.Hash64
+	buf [binary.MaxVarintLen64]byte
+}
+
+// Tags of nodes in the encoding of hasher
+const (
+	tagProgram byte = iota + 1
+	tagFunc
+	tagBlock
+	tagAssn
+	tagBinOp
+	tagCall
+	tagIfElse
+	tagIndex
+	tagLambda
+	tagWhile
+	tagBad
+	tagVar
+	tagVal
+	tagArg
+)
+
+func (h *hasher) tag(t byte) {
+	h.buf[0] = t
+	h.h.Write(h.buf[:1])
+}
+
+func (h *hasher) int(i int) {
+	n := binary.PutVarint(h.buf[:], int64(i))
+	h.h.Write(h.buf[:n])
+}
+
+func (h *hasher) str(s string) {
+	h.int(len(s))
+	h.h.Write([]byte(s))
+}
+
+func (h *hasher) strs(ss []string) {
+	h.int(len(ss))
+	for _, s := range ss {
+		h.str(s)
+	}
+}
+
+func (h *hasher) exprs(es []Expr) {
+	h.int(len(es))
+	for _, e := range es {
+		h.expr(e)
+	}
+}
+
+func (h *hasher) fn(f FuncDecl) {
+	h.tag(tagFunc)
+	h.str(f.Identifier)
+	h.strs(f.Params)
+	h.expr(f.Code)
+}
+
+func (h *hasher) expr(e Expr) {
+	switch e := e.(type) {
+	case Program:
+		h.tag(tagProgram)
+		h.int(len(e.Funcs))
+		for _, f := range e.Funcs {
+			h.fn(f)
+		}
+		h.expr(e.Code)
+	case Block:
+		h.tag(tagBlock)
+		h.exprs(e)
+	case Assn:
+		h.tag(tagAssn)
+		h.str(string(e.V))
+		h.expr(e.E)
+	case BinOp:
+		h.tag(tagBinOp)
+		h.int(int(e.Op))
+		h.expr(e.Lhs)
+		h.expr(e.Rhs)
+	case Call:
+		h.tag(tagCall)
+		h.expr(e.Fn)
+		h.exprs(e.Args)
+	case IfElse:
+		h.tag(tagIfElse)
+		h.expr(e.Cond)
+		h.expr(e.Then)
+		h.expr(e.Else)
+	case Index:
+		h.tag(tagIndex)
+		h.expr(e.Source)
+		h.expr(e.I)
+	case Lambda:
+		h.tag(tagLambda)
+		h.strs(e.Params)
+		h.expr(e.Code)
+	case While:
+		h.tag(tagWhile)
+		h.expr(e.Cond)
+		h.expr(e.Body)
+	case Bad:
+		h.tag(tagBad)
+	case Var:
+		h.tag(tagVar)
+		h.str(string(e))
+	case Val:
+		h.tag(tagVal)
+		h.str(string(e))
+	case Arg:
+		h.tag(tagArg)
+		h.int(int(e))
+	}
+}
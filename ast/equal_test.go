@@ -0,0 +1,106 @@
+package ast_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+func parse(t *testing.T, src string) ast.Expr {
+	t.Helper()
+	e, err := stringlang.Parse([]byte(src))
+	if err != nil {
+		t.Fatalf("parsing %q: %v", src, err)
+	}
+	return e
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{`x = "a" + %0`, "x  =\n\"a\"+%0 /* comment */", true},
+		{`/* doc */ fun f(a) { a } f("x")`, `fun f(a) {a} f("x")`, true},
+		{`if (a) { b } else if (c) { d } else { e }`, `if (a) { b } else { if (c) { d } else { e } }`, false},
+		{`a + b + c`, `a + (b + c)`, false},
+		{`fun f(a) { a } f("x")`, `fun g(a) { a } f("x")`, false},
+		{`fun(a) { a }`, `fun(b) { b }`, false},
+		{`x[0]`, `x["0"]`, true},
+		{`%1`, `"%1"`, false},
+	}
+	for _, tt := range tests {
+		a, b := parse(t, tt.a), parse(t, tt.b)
+		if got := ast.Equal(a, b); got != tt.equal {
+			t.Errorf("Equal(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.equal)
+		}
+		if tt.equal && ast.Hash(a) != ast.Hash(b) {
+			t.Errorf("Hash(%q) != Hash(%q)", tt.a, tt.b)
+		}
+	}
+}
+
+func TestAlphaEqual(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{`fun(a, b) { c = a + b; c }`, `fun(x, y) { z = x + y; z }`, true},
+		{`fun(a, b) { a }`, `fun(x, y) { y }`, false},
+		// Captured variables keep their names
+		{`fun(a) { a + y }`, `fun(b) { b + y }`, true},
+		{`fun(a) { a + y }`, `fun(b) { b + z }`, false},
+		{`fun(a) { a + y }`, `fun(y) { y + y }`, false},
+		{`fun(a) { x = x + a; x }`, `fun(b) { y = y + b; y }`, false},
+		// Called names refer to functions first
+		{`fun(f) { f("a") }`, `fun(g) { g("a") }`, false},
+		{`fun(a) { length(a) }`, `fun(b) { length(b) }`, true},
+		{`fun(a) { fun(b) { a + b } }`, `fun(x) { fun(y) { x + y } }`, true},
+		{`fun(a) { fun(b) { a + b } }`, `fun(x) { fun(y) { y + x } }`, false},
+		{`fun(a) { v0 = a; v0 }`, `fun(b) { c = b; c }`, true},
+		{`x = fun(a) { a }; y = "1"`, `x = fun(b) { b }; y = "1"`, true},
+		{`x = fun(a) { a }; y = "1"`, `z = fun(b) { b }; y = "1"`, false},
+	}
+	for _, tt := range tests {
+		a, b := parse(t, tt.a), parse(t, tt.b)
+		if got := ast.AlphaEqual(a, b); got != tt.equal {
+			t.Errorf("AlphaEqual(%q, %q) = %v, want %v\ncanonical: %s\n           %s", tt.a, tt.b, got, tt.equal,
+				ast.Canonical(a), ast.Canonical(b))
+		}
+	}
+}
+
+func TestAlphaEqualFuncs(t *testing.T) {
+	a := parse(t, `fun f(a, b) { c = a + b; g(c + d) } ""`).(ast.Program).Funcs[0]
+	b := parse(t, `fun f(x, y) { z = x + y; g(z + w) } ""`).(ast.Program).Funcs[0]
+	c := parse(t, `fun f(x, y) { z = x + y; h(z + w) } ""`).(ast.Program).Funcs[0]
+	if !ast.AlphaEqualFuncs(a, b) {
+		t.Errorf("AlphaEqualFuncs(%s, %s) = false, want true", a, b)
+	}
+	if ast.AlphaEqualFuncs(a, c) {
+		t.Errorf("AlphaEqualFuncs(%s, %s) = true, want false", a, c)
+	}
+}
+
+func TestCanonicalLambdas(t *testing.T) {
+	const src = `y = "1"; f = fun(a) { a + y }; g = fun(b) { b + y }; if (f == g) { "equal" } else { "different" } + f("x")`
+	compiled, err := stringlang.Compile([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, canonical := range []bool{false, true} {
+		want := "differentx1"
+		if canonical {
+			want = "equalx1"
+		}
+		got, err := compiled.Run(context.Background(), stringlang.RunOptions{CanonicalLambdas: canonical})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("with CanonicalLambdas %v: got %q, want %q", canonical, got, want)
+		}
+	}
+}
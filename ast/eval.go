@@ -0,0 +1,80 @@
+package ast
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidEvalSource is reported when the source passed to eval doesn't parse
+var ErrInvalidEvalSource = errors.New("invalid source")
+
+// EvalBuiltin returns the built-in function eval(src), which evaluates the StringLang source code src in the frame of
+// its caller: src can read and assign the variables of the calling function (or the top level), and functions declared
+// in src are available to the whole program afterwards.
+//
+// The evaluated code is part of the running evaluation, hence it shares its memory and call depth limits, its
+// cancellation and its Policy, which is also checked statically before src is evaluated. Sources which don't parse
+// abort the evaluation with ErrInvalidEvalSource.
+func EvalBuiltin() BuiltinFunc {
+	return BuiltinFunc{
+		Identifier: "eval",
+		Min:        0,
+		Max:        1,
+		Documentation: "eval(src) evaluates the StringLang source code src in the scope of its caller and returns its " +
+			"result.",
+		Fn: func(cc *CallContext, args []string) (string, error) {
+			if len(args) == 0 {
+				return "", nil
+			}
+			return evalIn(cc.frame, args[0])
+		},
+	}
+}
+
+// EvalIsolatedBuiltin returns the built-in function eval_isolated(src), which evaluates the StringLang source code
+// src like EvalBuiltin, but in a new frame without any variables. Variables assigned and functions declared in src
+// are discarded afterwards, hence src can't interfere with its caller other than through its result.
+func EvalIsolatedBuiltin() BuiltinFunc {
+	return BuiltinFunc{
+		Identifier: "eval_isolated",
+		Min:        0,
+		Max:        1,
+		Documentation: "eval_isolated(src) evaluates the StringLang source code src in a new, empty scope and returns " +
+			"its result.",
+		Fn: func(cc *CallContext, args []string) (string, error) {
+			if len(args) == 0 {
+				return "", nil
+			}
+			c := cc.frame
+			// Like a call of a user-defined function, the new frame is charged until it is done
+			if !c.charge(GoStackframeEstimate) {
+				return "", nil
+			}
+			c.state()
+			cNew := *c
+			cNew.VariableMap = make(map[Var]Val)
+			cNew.UserFunctionMap = make(map[string]FuncDecl, len(c.UserFunctionMap))
+			for name, f := range c.UserFunctionMap {
+				cNew.UserFunctionMap[name] = f
+			}
+			res, err := evalIn(&cNew, args[0])
+			c.release(GoStackframeEstimate + CheckSize(cNew.VariableMap))
+			return res, err
+		},
+	}
+}
+
+// evalIn parses src and evaluates it using the frame c of the running evaluation
+func evalIn(c *Context, src string) (string, error) {
+	expr, err := c.parseFn([]byte(src))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidEvalSource, err)
+	}
+	if prog, ok := expr.(Program); ok {
+		if err := c.CheckPolicy(prog); err != nil {
+			return "", err
+		}
+	}
+	// Errors occurring while evaluating abort the evaluation themselves
+	return string(c.eval(expr)), nil
+}
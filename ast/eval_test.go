@@ -0,0 +1,68 @@
+package ast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+func TestEvalBuiltins(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{`x = "a"; eval("x + \"b\"")`, "ab"},
+		{`eval("x = \"c\""); x`, "c"},
+		{`fun f() { y = "local"; eval("y") } y = "global"; f() + y`, "localglobal"},
+		{`eval("fun g() { \"g\" } \"\""); g()`, "g"},
+		{`x = "a"; eval_isolated("x")`, ""},
+		{`x = "a"; eval_isolated("x = \"c\"") + x`, "ca"},
+		{`fun g() { "g" } eval_isolated("g()")`, "g"},
+		{`fun g() { "outer" } eval_isolated("fun g() { \"inner\" } g()") + g()`, "innerouter"},
+		{`eval_isolated("fun h() { \"h\" } h()")`, "h"},
+	}
+	for _, tt := range tests {
+		c := stringlang.NewContextBuiltins(nil, ast.EvalBuiltin(), ast.EvalIsolatedBuiltin())
+		got, err := c.EvalContext(context.Background(), parse(t, tt.src))
+		if err != nil || string(got) != tt.want {
+			t.Errorf("%s: got %q, %v, want %q", tt.src, got, err, tt.want)
+		}
+	}
+
+	// Functions declared in isolation are discarded afterwards
+	c := stringlang.NewContextBuiltins(nil, ast.EvalBuiltin(), ast.EvalIsolatedBuiltin())
+	c.EvalContext(context.Background(), parse(t, `eval_isolated("fun h() { \"h\" } \"\"")`))
+	if _, ok := c.UserFunctionMap["h"]; ok {
+		t.Errorf("eval_isolated declared h for its caller")
+	}
+
+	for _, src := range []string{`eval("(")`, `eval_isolated("(")`} {
+		c := stringlang.NewContextBuiltins(nil, ast.EvalBuiltin(), ast.EvalIsolatedBuiltin())
+		if _, err := c.EvalContext(context.Background(), parse(t, src)); !errors.Is(err, ast.ErrInvalidEvalSource) {
+			t.Errorf("%s: got error %v, want %v", src, err, ast.ErrInvalidEvalSource)
+		}
+	}
+}
+
+func TestEvalInvalidOperations(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{`"abc"["-1"]`, ""},
+		{`"abc"["3"]`, ""},
+		{`"abc"["x"]`, ""},
+		{`x = "a"; undefined(x = "b") + x`, "b"},
+		{`f = "not a lambda"; f(x = "b") + x`, "b"},
+	}
+	for _, tt := range tests {
+		c := stringlang.NewContext(nil, nil)
+		got, err := c.EvalContext(context.Background(), parse(t, tt.src))
+		if err != nil || string(got) != tt.want {
+			t.Errorf("%s: got %q, %v, want %q", tt.src, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package ast
+
+// Hooks observe evaluations, e.g. for tracing, metrics or debugging, see Context.SetHooks. All callbacks are called
+// synchronously on the evaluating goroutine with the frame c they happen in, and must not modify the evaluation other
+// than through the methods of Context. Embed NopHooks to only implement some of them.
+type Hooks interface {
+	// EnterEval is called before e is evaluated
+	EnterEval(c *Context, e Expr)
+	// ExitEval is called after e was evaluated to v, which is meaningless if c.Err() is non-nil
+	ExitEval(c *Context, e Expr, v Val)
+	// CallFunc is called after the arguments of a call of the function name were evaluated, c is the calling frame
+	CallFunc(c *Context, kind CallKind, name string, args []Val)
+	// ReturnFunc is called after the function name called from frame c returned res
+	ReturnFunc(c *Context, kind CallKind, name string, res Val)
+	// Assign is called after the variable v was assigned val
+	Assign(c *Context, v Var, val Val)
+	// LoopIteration is called before the body of the loop w is evaluated for the iteration-th time, counting from 0
+	LoopIteration(c *Context, w While, iteration int)
+}
+
+// PanicHooks are Hooks which are told about panics EvalContext recovered from, e.g. to reset state kept across the
+// ExitEval and ReturnFunc calls the panic skipped
+type PanicHooks interface {
+	Hooks
+	// Panicked is called after EvalContext recovered from panic r of the evaluation using c
+	Panicked(c *Context, r interface{})
+}
+
+// CallKind is the kind of function called, see Hooks
+type CallKind int
+
+const (
+	UserCall    CallKind = iota // A function declared by the program
+	BuiltinCall                 // A Builtin
+	LambdaCall                  // A lambda, the name is the expression evaluating to it
+)
+
+func (k CallKind) String() string {
+	switch k {
+	case UserCall:
+		return "user function"
+	case BuiltinCall:
+		return "built-in function"
+	case LambdaCall:
+		return "lambda"
+	}
+	return "unknown"
+}
+
+// NopHooks implements Hooks by doing nothing
+type NopHooks struct{}
+
+func (NopHooks) EnterEval(*Context, Expr)                   {}
+func (NopHooks) ExitEval(*Context, Expr, Val)               {}
+func (NopHooks) CallFunc(*Context, CallKind, string, []Val) {}
+func (NopHooks) ReturnFunc(*Context, CallKind, string, Val) {}
+func (NopHooks) Assign(*Context, Var, Val)                  {}
+func (NopHooks) LoopIteration(*Context, While, int)         {}
+
+// SetHooks makes further evaluations using c report to h, nil removes the hooks
+func (c *Context) SetHooks(h Hooks) {
+	c.Hooks = h
+	if h != nil && c.lambdas == nil {
+		c.lambdas = make(map[Val]Lambda)
+	}
+}
+
+// eval evaluates e using frame c, reporting to the hooks of c if there are any
+func (c *Context) eval(e Expr) Val {
+	if c.Hooks == nil {
+		return e.Eval(c)
+	}
+	c.Hooks.EnterEval(c, e)
+	v := e.Eval(c)
+	c.Hooks.ExitEval(c, e, v)
+	return v
+}
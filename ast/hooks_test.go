@@ -0,0 +1,80 @@
+package ast_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+// logHooks logs the calls, assignments and loop iterations it observes, and the evaluations of calls
+type logHooks struct {
+	log []string
+}
+
+func (h *logHooks) EnterEval(c *ast.Context, e ast.Expr) {
+	if _, ok := e.(ast.Call); ok {
+		h.log = append(h.log, "enter "+e.String())
+	}
+}
+
+func (h *logHooks) ExitEval(c *ast.Context, e ast.Expr, v ast.Val) {
+	if _, ok := e.(ast.Call); ok {
+		h.log = append(h.log, fmt.Sprintf("exit %s = %s", e, v))
+	}
+}
+
+func (h *logHooks) CallFunc(c *ast.Context, kind ast.CallKind, name string, args []ast.Val) {
+	h.log = append(h.log, fmt.Sprintf("call %s %s%s", kind, name, args))
+}
+
+func (h *logHooks) ReturnFunc(c *ast.Context, kind ast.CallKind, name string, res ast.Val) {
+	h.log = append(h.log, fmt.Sprintf("return %s %s = %s", kind, name, res))
+}
+
+func (h *logHooks) Assign(c *ast.Context, v ast.Var, val ast.Val) {
+	h.log = append(h.log, fmt.Sprintf("assign %s = %s", v, val))
+}
+
+func (h *logHooks) LoopIteration(c *ast.Context, w ast.While, iteration int) {
+	h.log = append(h.log, fmt.Sprintf("iteration %d", iteration))
+}
+
+func TestHooks(t *testing.T) {
+	const src = `fun f(x) { y = x + "!"; y } l = fun(s) { s }; i = ""; while (i != "xx") { i = i + "x" }; f(upper(l("a")))`
+	c := stringlang.NewContextBuiltins(nil,
+		ast.PlainBuiltin("upper", func(args []string) string { return strings.ToUpper(args[0]) }))
+	h := &logHooks{}
+	c.SetHooks(h)
+	if _, err := c.EvalContext(context.Background(), parse(t, src)); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`assign l = "fun(s) {\n\ts\n}"`,
+		`assign i = ""`,
+		`iteration 0`,
+		`assign i = "x"`,
+		`iteration 1`,
+		`assign i = "xx"`,
+		`enter f(upper(l("a")))`,
+		`enter upper(l("a"))`,
+		`enter l("a")`,
+		`call lambda l["a"]`,
+		`return lambda l = "a"`,
+		`exit l("a") = "a"`,
+		`call built-in function upper["a"]`,
+		`return built-in function upper = "A"`,
+		`exit upper(l("a")) = "A"`,
+		`call user function f["A"]`,
+		`assign y = "A!"`,
+		`return user function f = "A!"`,
+		`exit f(upper(l("a"))) = "A!"`,
+	}
+	if !reflect.DeepEqual(h.log, want) {
+		t.Errorf("got\n%s\nwant\n%s", strings.Join(h.log, "\n"), strings.Join(want, "\n"))
+	}
+}
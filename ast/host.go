@@ -0,0 +1,59 @@
+package ast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNotAFunction is returned when the host calls something that isn't a function
+var ErrNotAFunction = errors.New("not a function")
+
+// Call calls the function name with args, like evaluating the expression name(args...) would: name is either a
+// top-level user-defined function, a built-in function or a variable holding a lambda. See CallWith.
+func (c *Context) Call(name string, args ...string) (string, error) {
+	return c.CallWith(context.Background(), name, args...)
+}
+
+// CallWith is like Call, but the call is aborted as soon as goCtx is done, see EvalContext. If it is called by a
+// built-in function during an evaluation, the call becomes part of that evaluation.
+func (c *Context) CallWith(goCtx context.Context, name string, args ...string) (string, error) {
+	_, isUserFn := c.UserFunctionMap[name]
+	_, isBuiltin := c.FunctionMap[name]
+	if !isUserFn && !isBuiltin {
+		if _, err := c.parseLambda(c.VariableMap[Var(name)]); err != nil {
+			return "", fmt.Errorf("%s: %w", name, ErrNotAFunction)
+		}
+	}
+	return c.evalCall(goCtx, Var(name), args)
+}
+
+// CallLambda calls the lambda with source lambda, i.e. the value of a lambda expression, with args
+func (c *Context) CallLambda(goCtx context.Context, lambda string, args ...string) (string, error) {
+	if _, err := c.parseLambda(Val(lambda)); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrNotAFunction, err)
+	}
+	return c.evalCall(goCtx, Val(lambda), args)
+}
+
+func (c *Context) evalCall(goCtx context.Context, fn Expr, args []string) (string, error) {
+	callArgs := make(CallArgs, len(args))
+	for i := range args {
+		callArgs[i] = Val(args[i])
+	}
+	res, err := c.EvalContext(goCtx, Call{Fn: fn, Args: callArgs})
+	return string(res), err
+}
+
+// Get returns the value of the top-level variable name and whether it has been assigned
+func (c *Context) Get(name string) (string, bool) {
+	val, ok := c.VariableMap[Var(name)]
+	return string(val), ok
+}
+
+// Set assigns value to the top-level variable name. The host isn't subject to MaxMemory, but the value counts towards
+// the memory usage of the program from now on.
+func (c *Context) Set(name, value string) {
+	c.state().mem += sizeDelta(c.VariableMap, Var(name), Val(value))
+	c.VariableMap[Var(name)] = Val(value)
+}
@@ -0,0 +1,123 @@
+package ast_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+const hostSource = `
+fun greet(name) { "Hello " + name }
+fun loop() { while ("true") { x = "" } }
+count = "0";
+exclaim = fun(s) { s + "!" };
+""
+`
+
+// hostContext returns a Context which evaluated hostSource
+func hostContext(t *testing.T) *ast.Context {
+	t.Helper()
+	compiled, err := stringlang.Compile([]byte(hostSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := compiled.NewContext(stringlang.RunOptions{Builtins: []stringlang.Builtin{
+		ast.PlainBuiltin("upper", func(args []string) string { return strings.ToUpper(args[0]) }),
+	}})
+	if _, err := c.EvalContext(context.Background(), compiled.Program().Code); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestHostCall(t *testing.T) {
+	c := hostContext(t)
+	tests := []struct {
+		name string
+		args []string
+		want string
+		err  error
+	}{
+		{"greet", []string{"you"}, "Hello you", nil},
+		{"exclaim", []string{"hi"}, "hi!", nil},
+		{"upper", []string{"abc"}, "ABC", nil},
+		{"count", nil, "", ast.ErrNotAFunction},
+		{"missing", nil, "", ast.ErrNotAFunction},
+	}
+	for _, tt := range tests {
+		got, err := c.Call(tt.name, tt.args...)
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Errorf("Call(%q, %q) = %q, %v, want %q, %v", tt.name, tt.args, got, err, tt.want, tt.err)
+		}
+	}
+
+	lambda, _ := c.Get("exclaim")
+	if got, err := c.CallLambda(context.Background(), lambda, "lambda"); err != nil || got != "lambda!" {
+		t.Errorf("CallLambda(%q) = %q, %v, want %q", lambda, got, err, "lambda!")
+	}
+	if _, err := c.CallLambda(context.Background(), "no lambda"); !errors.Is(err, ast.ErrNotAFunction) {
+		t.Errorf("CallLambda of a value: got error %v, want %v", err, ast.ErrNotAFunction)
+	}
+}
+
+func TestHostCallWithCancel(t *testing.T) {
+	c := hostContext(t)
+	goCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.CallWith(goCtx, "loop"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if got, err := c.Call("greet", "again"); err != nil || got != "Hello again" {
+		t.Errorf("calling after a cancelled call: got %q, %v", got, err)
+	}
+}
+
+func TestHostCallFromBuiltin(t *testing.T) {
+	c := hostContext(t)
+	err := c.Register("greet_twice", func(name string) (string, error) {
+		// Part of the running evaluation, hence subject to its call depth
+		return c.Call("greet", name+" and "+name)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.EvalContext(context.Background(), parse(t, `greet_twice("x")`))
+	if err != nil || got != "Hello x and x" {
+		t.Errorf("got %q, %v, want %q", got, err, "Hello x and x")
+	}
+	c.SetMaxCallDepth(1)
+	if _, err := c.EvalContext(context.Background(), parse(t, `greet_twice("x")`)); !errors.Is(err, ast.ErrRecursionLimit) {
+		t.Errorf("got error %v, want %v", err, ast.ErrRecursionLimit)
+	}
+}
+
+func TestHostGetSet(t *testing.T) {
+	c := hostContext(t)
+	if got, ok := c.Get("count"); !ok || got != "0" {
+		t.Errorf(`Get("count") = %q, %v, want "0", true`, got, ok)
+	}
+	if got, ok := c.Get("missing"); ok || got != "" {
+		t.Errorf(`Get("missing") = %q, %v, want "", false`, got, ok)
+	}
+
+	c.Set("count", "5")
+	got, err := c.EvalContext(context.Background(), parse(t, `count = count + "1"; count`))
+	if err != nil || got != "51" {
+		t.Errorf("got %q, %v, want %q", got, err, "51")
+	}
+	if got, _ := c.Get("count"); got != "51" {
+		t.Errorf(`Get("count") = %q after the program assigned it, want "51"`, got)
+	}
+
+	// Values the host sets count towards the memory usage of the program
+	c.SetMaxMemory(c.MemoryUsage() + 100)
+	c.Set("big", strings.Repeat("a", 90))
+	if _, err := c.EvalContext(context.Background(), parse(t, `copy = big`)); err != ast.ErrMemoryLimitExceeded {
+		t.Errorf("got error %v, want %v", err, ast.ErrMemoryLimitExceeded)
+	}
+}
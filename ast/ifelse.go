@@ -6,30 +6,29 @@ type IfElse struct {
 	Cond Expr
 	Then Expr
 	Else Expr
+	Pos  Pos // Position of the "if" keyword
 }
 
-func NewIfElse(c, t, e Attrib) (Expr, error) {
-	co := c.(Expr)
-	th := t.(Expr)
-	el := e.(Expr)
-	return IfElse{Cond: co, Then: th, Else: el}, nil
-}
 func (e IfElse) Eval(c *Context) Val {
-	if BoolOf(e.Cond.Eval(c)) {
-		return e.Then.Eval(c)
+	if BoolOf(c.eval(e.Cond)) {
+		return c.eval(e.Then)
 	} else {
-		return e.Else.Eval(c)
+		return c.eval(e.Else)
 	}
 }
 func (e IfElse) String() string {
 	thenLines := strings.Split(e.Then.String(), "\n")
 	thenStr := strings.Join(thenLines, "\n\t")
+	str := "if (" + e.Cond.String() + ") {\n\t" + thenStr + "\n} else "
+	if _, ok := e.Else.(IfElse); ok {
+		// An else-if, which has no block of its own
+		return str + e.Else.String()
+	}
 
 	elseLines := strings.Split(e.Else.String(), "\n")
 	elseStr := strings.Join(elseLines, "\n\t")
 
-	str := "if (" + e.Cond.String() + ") {\n\t" + thenStr + "\n} else {\n\t" + elseStr + "\n}"
-	return str
+	return str + "{\n\t" + elseStr + "\n}"
 }
 func (e IfElse) Precedence() int {
 	// Leaf, not operator
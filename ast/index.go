@@ -5,21 +5,21 @@ import "strconv"
 type Index struct {
 	Source Expr
 	I      Expr
+	Pos    Pos // Position of the "["
 }
 
-func NewIndex(s, i Attrib) (Expr, error) {
-	return Index{Source: s.(Expr), I: i.(Expr)}, nil
-}
-func NewIndexInt(s, i Attrib) (Expr, error) {
-	return Index{Source: s.(Expr), I: Val(attribToString(i))}, nil
-}
 func (i Index) Eval(c *Context) Val {
-	src := string(i.Source.Eval(c))
-	idx, err := strconv.Atoi(string(i.I.Eval(c)))
+	srcVal := c.eval(i.Source)
+	if !c.hold(srcVal) {
+		return ""
+	}
+	defer c.unhold(srcVal)
+	src := string(srcVal)
+	idx, err := strconv.Atoi(string(c.eval(i.I)))
 	if err != nil {
 		return Val("")
 	}
-	if idx >= len(src) {
+	if idx < 0 || idx >= len(src) {
 		return Val("")
 	}
 	return Val(src[idx])
@@ -0,0 +1,113 @@
+package ast
+
+import (
+	"sort"
+)
+
+// ProgramInfo describes what a program defines and what it needs from its host
+type ProgramInfo struct {
+	Funcs []FuncInfo // Top-level functions in order of declaration
+	// Builtins are the names of called functions that are neither user-defined nor variables, i.e. which the host
+	// needs to provide as built-in functions
+	Builtins []string
+	Args     []int // Referenced program arguments, e.g. 1 for %1
+	// UsesEval reports whether the program calls one of the EvalBuiltins, which run arbitrary source
+	UsesEval bool
+	// DynamicCalls reports whether the program calls values as lambdas, e.g. variables or parameters holding lambdas
+	DynamicCalls bool
+}
+
+// FuncInfo describes a top-level function
+type FuncInfo struct {
+	Name   string
+	Params []string
+	Doc    string
+	Pos    Pos
+	// Globals are the variables the function reads before assigning them. Functions can't see the variables of their
+	// callers, hence these always start out as "".
+	Globals []string
+}
+
+// Info returns a description of p, e.g. for hosts listing the commands a script defines
+func (p Program) Info() ProgramInfo {
+	funcNames := make(Set, len(p.Funcs))
+	for _, f := range p.Funcs {
+		funcNames.Add(f.Identifier)
+	}
+
+	col := &infoCollector{funcNames: funcNames, args: make(map[int]struct{})}
+	builtins := make(Set)
+	info := ProgramInfo{Funcs: make([]FuncInfo, 0, len(p.Funcs))}
+	for _, f := range p.Funcs {
+		params := SetFrom(f.Params...)
+		called := col.collect(f.Code, DefinedVars(f.Code).Union(params))
+		builtins.Union(called)
+
+		// Names the function calls as built-in functions aren't variables it reads
+		globals := UsedBeforeDefVars(f.Code, funcNames).Except(params).Except(called)
+		info.Funcs = append(info.Funcs, FuncInfo{
+			Name:    f.Identifier,
+			Params:  append([]string(nil), f.Params...),
+			Doc:     f.Doc,
+			Pos:     f.Pos,
+			Globals: globals.Sorted(),
+		})
+	}
+	builtins.Union(col.collect(p.Code, DefinedVars(p.Code)))
+
+	info.Builtins = builtins.Sorted()
+	for _, name := range EvalBuiltins {
+		info.UsesEval = info.UsesEval || builtins.Contains(name)
+	}
+	info.DynamicCalls = col.dynamic
+	for a := range col.args {
+		info.Args = append(info.Args, a)
+	}
+	sort.Ints(info.Args)
+	return info
+}
+
+type infoCollector struct {
+	funcNames Set
+	builtins  Set // Names called as built-in functions by the expression being collected
+	args      map[int]struct{}
+	dynamic   bool
+}
+
+// collect records the calls and arguments of expr, which is evaluated in a scope defining the variables in scope, and
+// returns the names expr calls as built-in functions
+func (col *infoCollector) collect(expr Expr, scope Set) Set {
+	col.builtins = make(Set)
+	Walk(infoVisitor{col: col, scope: scope}, expr)
+	return col.builtins
+}
+
+// infoVisitor collects into col, visiting expressions evaluated in a scope defining the variables in scope
+type infoVisitor struct {
+	col   *infoCollector
+	scope Set
+}
+
+func (v infoVisitor) Visit(e Expr) Visitor {
+	switch val := e.(type) {
+	case nil:
+		return nil
+	case Arg:
+		v.col.args[int(val)] = struct{}{}
+	case Call:
+		fnVar, isVar := val.Fn.(Var)
+		switch {
+		case isVar && v.col.funcNames.Contains(string(fnVar)):
+			// Call of a user-defined function
+		case isVar && !v.scope.Contains(string(fnVar)):
+			v.col.builtins.Add(string(fnVar))
+		default:
+			v.col.dynamic = true
+		}
+	case Lambda:
+		// Lambdas capture the variables of their scope
+		inner := v.scope.UnionCopy(SetFrom(val.Params...)).Union(DefinedVars(val.Code))
+		return infoVisitor{col: v.col, scope: inner}
+	}
+	return v
+}
@@ -0,0 +1,64 @@
+package ast_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/skius/stringlang/ast"
+)
+
+func TestInfo(t *testing.T) {
+	const src = `/* Greets name */
+fun greet(name) { prefix + name + suffix(name) }
+
+fun twice(f, x) { f(f(x)) }
+
+out = greet(%1);
+shout = fun(s) { upper(s) + %0 };
+if (%2 == "eval") { eval(out) } else { twice(shout, out) }`
+	e := parse(t, src)
+	want := ast.ProgramInfo{
+		Funcs: []ast.FuncInfo{
+			{
+				Name:    "greet",
+				Params:  []string{"name"},
+				Doc:     "Greets name",
+				Pos:     ast.Pos{Offset: 18, Line: 2, Column: 1},
+				Globals: []string{"prefix"},
+			},
+			{
+				Name:    "twice",
+				Params:  []string{"f", "x"},
+				Pos:     ast.Pos{Offset: 68, Line: 4, Column: 1},
+				Globals: []string{},
+			},
+		},
+		Builtins:     []string{"eval", "suffix", "upper"},
+		Args:         []int{0, 1, 2},
+		UsesEval:     true,
+		DynamicCalls: true,
+	}
+	if got := e.(ast.Program).Info(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v\nwant %#v", got, want)
+	}
+
+	e = parse(t, `fun f() { "a" } f() + %3`)
+	want = ast.ProgramInfo{
+		Funcs:    []ast.FuncInfo{{Name: "f", Pos: ast.Pos{Line: 1, Column: 1}, Globals: []string{}}},
+		Builtins: []string{},
+		Args:     []int{3},
+	}
+	if got := e.(ast.Program).Info(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v\nwant %#v", got, want)
+	}
+
+	// Names called in one function are still variables in another
+	e = parse(t, `fun a() { x() } fun b() { x } a() + b()`)
+	got := e.(ast.Program).Info()
+	if globals := got.Funcs[1].Globals; !reflect.DeepEqual(globals, []string{"x"}) {
+		t.Errorf("got globals %q of b, want [x]", globals)
+	}
+	if !reflect.DeepEqual(got.Builtins, []string{"x"}) {
+		t.Errorf("got builtins %q, want [x]", got.Builtins)
+	}
+}
@@ -0,0 +1,379 @@
+package ast
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// JSONVersion is the version of the JSON encoding of EncodeJSON. It's increased whenever the encoding changes in a way
+// older decoders can't read.
+const JSONVersion = 1
+
+// ErrJSONVersion is returned when decoding JSON of an unsupported version
+var ErrJSONVersion = errors.New("unsupported version of JSON encoding")
+
+// EncodeJSON returns the JSON encoding of e, which DecodeJSON decodes to e again, including positions and doc comments.
+// It's an object with the fields "version", which is JSONVersion, and "expr", the encoding of e.
+//
+// Expressions are encoded as objects with a "type" field, the name of their type in this package, and "pos", the
+// object with the fields "offset", "line" and "column" of their position, unless it's unknown. Depending on the type,
+// they have the following fields:
+//
+//	Program: "funcs", an array of objects with the fields "name", "params", "code", "doc" and "pos", and "code"
+//	Block:   "exprs"
+//	Assn:    "name", "expr"
+//	BinOp:   "op", the operator as written in source code, "lhs", "rhs"
+//	Call:    "fn", "args"
+//	IfElse:  "cond", "then", "else"
+//	Index:   "source", "index"
+//	Lambda:  "params", "code"
+//	While:   "cond", "body"
+//	Var:     "name"
+//	Val:     "value"
+//	Arg:     "number"
+//
+// Bad has no fields but "pos". Fields named "code", "then" and "body" hold Blocks, "else" a Block or an IfElse, and
+// "params" arrays of strings. Fields holding empty arrays or doc comments may be omitted.
+func EncodeJSON(e Expr) ([]byte, error) {
+	node, err := toJSON(e)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(jsonAST{Version: JSONVersion, Expr: node})
+}
+
+// DecodeJSON decodes the JSON encoding of an expression returned by EncodeJSON. It fails with ErrJSONVersion if the
+// encoding is of a different version, and for trees the parser can't produce, e.g. a Program below the root or a Block
+// where it expects an expression. Like programs built by Go code, decoded ones aren't limited in nesting depth as
+// parsed ones are, see ExceedsDepth.
+func DecodeJSON(data []byte) (Expr, error) {
+	var tree jsonAST
+	if err := json.Unmarshal(data, &tree); err != nil {
+		return nil, err
+	}
+	if tree.Version != JSONVersion {
+		return nil, fmt.Errorf("%w %d, want %d", ErrJSONVersion, tree.Version, JSONVersion)
+	}
+	if tree.Expr != nil {
+		switch tree.Expr.Type {
+		case "Program":
+			return fromJSONProgram(tree.Expr)
+		case "Block":
+			return fromJSONBlock(tree.Expr)
+		}
+	}
+	return fromJSON(tree.Expr)
+}
+
+type (
+	jsonAST struct {
+		Version int       `json:"version"`
+		Expr    *jsonNode `json:"expr"`
+	}
+	// jsonNode is the encoding of every type of expression, using the fields of its type
+	jsonNode struct {
+		Type   string      `json:"type"`
+		Pos    *jsonPos    `json:"pos,omitempty"`
+		Funcs  []jsonFunc  `json:"funcs,omitempty"`
+		Code   *jsonNode   `json:"code,omitempty"`
+		Exprs  []*jsonNode `json:"exprs,omitempty"`
+		Name   *string     `json:"name,omitempty"`
+		Expr   *jsonNode   `json:"expr,omitempty"`
+		Op     string      `json:"op,omitempty"`
+		Lhs    *jsonNode   `json:"lhs,omitempty"`
+		Rhs    *jsonNode   `json:"rhs,omitempty"`
+		Fn     *jsonNode   `json:"fn,omitempty"`
+		Args   []*jsonNode `json:"args,omitempty"`
+		Cond   *jsonNode   `json:"cond,omitempty"`
+		Then   *jsonNode   `json:"then,omitempty"`
+		Else   *jsonNode   `json:"else,omitempty"`
+		Source *jsonNode   `json:"source,omitempty"`
+		Index  *jsonNode   `json:"index,omitempty"`
+		Params []string    `json:"params,omitempty"`
+		Body   *jsonNode   `json:"body,omitempty"`
+		Value  *string     `json:"value,omitempty"`
+		Number *int        `json:"number,omitempty"`
+	}
+	jsonFunc struct {
+		Name   string    `json:"name"`
+		Params []string  `json:"params"`
+		Code   *jsonNode `json:"code"`
+		Doc    string    `json:"doc,omitempty"`
+		Pos    *jsonPos  `json:"pos,omitempty"`
+	}
+	jsonPos struct {
+		Offset int `json:"offset"`
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	}
+)
+
+func toJSONPos(p Pos) *jsonPos {
+	if !p.IsValid() {
+		return nil
+	}
+	return &jsonPos{Offset: p.Offset, Line: p.Line, Column: p.Column}
+}
+
+func fromJSONPos(p *jsonPos) Pos {
+	if p == nil {
+		return Pos{}
+	}
+	return Pos{Offset: p.Offset, Line: p.Line, Column: p.Column}
+}
+
+func toJSONs(es []Expr) ([]*jsonNode, error) {
+	nodes := make([]*jsonNode, len(es))
+	for i, e := range es {
+		node, err := toJSON(e)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = node
+	}
+	return nodes, nil
+}
+
+// toJSONs2 encodes a and b
+func toJSONs2(a, b Expr) (*jsonNode, *jsonNode, error) {
+	nodeA, err := toJSON(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	nodeB, err := toJSON(b)
+	return nodeA, nodeB, err
+}
+
+// emptyIfNil returns ss, or the empty slice if ss is nil, which is encoded as [] instead of null
+func emptyIfNil(ss []string) []string {
+	if ss == nil {
+		return []string{}
+	}
+	return ss
+}
+
+func toJSON(e Expr) (*jsonNode, error) {
+	var err error
+	node := &jsonNode{Pos: toJSONPos(PosOf(e))}
+	switch val := e.(type) {
+	case Program:
+		node.Type = "Program"
+		node.Funcs = make([]jsonFunc, len(val.Funcs))
+		for i, f := range val.Funcs {
+			code, err := toJSON(f.Code)
+			if err != nil {
+				return nil, err
+			}
+			node.Funcs[i] = jsonFunc{Name: f.Identifier, Params: emptyIfNil(f.Params), Code: code, Doc: f.Doc,
+				Pos: toJSONPos(f.Pos)}
+		}
+		node.Code, err = toJSON(val.Code)
+	case Block:
+		node.Type = "Block"
+		node.Exprs, err = toJSONs(val)
+	case Assn:
+		node.Type = "Assn"
+		name := string(val.V)
+		node.Name = &name
+		node.Expr, err = toJSON(val.E)
+	case BinOp:
+		node.Type = "BinOp"
+		switch val.Op {
+		case OrOp, AndOp, NotEqualsOp, EqualsOp, ConcatOp:
+			node.Op = val.Op.String()
+		default:
+			return nil, fmt.Errorf("unknown operator %d", int(val.Op))
+		}
+		node.Lhs, node.Rhs, err = toJSONs2(val.Lhs, val.Rhs)
+	case Call:
+		node.Type = "Call"
+		node.Fn, err = toJSON(val.Fn)
+		if err == nil {
+			node.Args, err = toJSONs(val.Args)
+		}
+	case IfElse:
+		node.Type = "IfElse"
+		node.Cond, node.Then, err = toJSONs2(val.Cond, val.Then)
+		if err == nil {
+			node.Else, err = toJSON(val.Else)
+		}
+	case Index:
+		node.Type = "Index"
+		node.Source, node.Index, err = toJSONs2(val.Source, val.I)
+	case Lambda:
+		node.Type = "Lambda"
+		node.Params = emptyIfNil(val.Params)
+		node.Code, err = toJSON(val.Code)
+	case While:
+		node.Type = "While"
+		node.Cond, node.Body, err = toJSONs2(val.Cond, val.Body)
+	case Bad:
+		node.Type = "Bad"
+	case Var:
+		node.Type = "Var"
+		name := string(val)
+		node.Name = &name
+	case Val:
+		node.Type = "Val"
+		value := string(val)
+		node.Value = &value
+	case Arg:
+		node.Type = "Arg"
+		number := int(val)
+		node.Number = &number
+	default:
+		return nil, fmt.Errorf("can't encode expression of type %T", e)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// jsonOps are the operators by their encoding
+var jsonOps = map[string]Op{
+	OrOp.String():        OrOp,
+	AndOp.String():       AndOp,
+	NotEqualsOp.String(): NotEqualsOp,
+	EqualsOp.String():    EqualsOp,
+	ConcatOp.String():    ConcatOp,
+}
+
+func fromJSONs(nodes []*jsonNode) ([]Expr, error) {
+	es := make([]Expr, len(nodes))
+	for i, node := range nodes {
+		e, err := fromJSON(node)
+		if err != nil {
+			return nil, err
+		}
+		es[i] = e
+	}
+	return es, nil
+}
+
+// fromJSONs2 decodes a and b
+func fromJSONs2(a, b *jsonNode) (Expr, Expr, error) {
+	exprA, err := fromJSON(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	exprB, err := fromJSON(b)
+	return exprA, exprB, err
+}
+
+func fromJSONProgram(node *jsonNode) (Program, error) {
+	prog := Program{Funcs: make([]FuncDecl, len(node.Funcs))}
+	for i, f := range node.Funcs {
+		code, err := fromJSONBlock(f.Code)
+		if err != nil {
+			return Program{}, fmt.Errorf("function %s: %w", f.Name, err)
+		}
+		prog.Funcs[i] = FuncDecl{Params: emptyIfNil(f.Params), Code: code, Identifier: f.Name, Doc: f.Doc,
+			Pos: fromJSONPos(f.Pos)}
+	}
+	code, err := fromJSONBlock(node.Code)
+	if err != nil {
+		return Program{}, err
+	}
+	prog.Code = code
+	return prog, nil
+}
+
+func fromJSONBlock(node *jsonNode) (Block, error) {
+	if node == nil {
+		return nil, errors.New("missing Block")
+	}
+	if node.Type != "Block" {
+		return nil, fmt.Errorf("got %s, want Block", node.Type)
+	}
+	es, err := fromJSONs(node.Exprs)
+	if err != nil {
+		return nil, err
+	}
+	return Block(es), nil
+}
+
+// fromJSON decodes an expression, which can't be a Program or a Block
+func fromJSON(node *jsonNode) (Expr, error) {
+	if node == nil {
+		return nil, errors.New("missing expression")
+	}
+	pos := fromJSONPos(node.Pos)
+	var err error
+	switch node.Type {
+	case "Program", "Block":
+		return nil, fmt.Errorf("got %s, want an expression", node.Type)
+	case "Assn":
+		if node.Name == nil {
+			return nil, errors.New("Assn without name")
+		}
+		a := Assn{V: Var(*node.Name), Pos: pos}
+		a.E, err = fromJSON(node.Expr)
+		return a, err
+	case "BinOp":
+		op, ok := jsonOps[node.Op]
+		if !ok {
+			return nil, fmt.Errorf("unknown operator %q", node.Op)
+		}
+		b := BinOp{Op: op, Pos: pos}
+		b.Lhs, b.Rhs, err = fromJSONs2(node.Lhs, node.Rhs)
+		return b, err
+	case "Call":
+		c := Call{Pos: pos}
+		if c.Fn, err = fromJSON(node.Fn); err != nil {
+			return nil, err
+		}
+		args, err := fromJSONs(node.Args)
+		c.Args = CallArgs(args)
+		return c, err
+	case "IfElse":
+		ie := IfElse{Pos: pos}
+		if ie.Cond, err = fromJSON(node.Cond); err != nil {
+			return nil, err
+		}
+		if ie.Then, err = fromJSONBlock(node.Then); err != nil {
+			return nil, err
+		}
+		// An else-if has no Block of its own
+		if node.Else != nil && node.Else.Type == "IfElse" {
+			ie.Else, err = fromJSON(node.Else)
+		} else {
+			ie.Else, err = fromJSONBlock(node.Else)
+		}
+		return ie, err
+	case "Index":
+		i := Index{Pos: pos}
+		i.Source, i.I, err = fromJSONs2(node.Source, node.Index)
+		return i, err
+	case "Lambda":
+		l := Lambda{Params: emptyIfNil(node.Params), Pos: pos}
+		l.Code, err = fromJSONBlock(node.Code)
+		return l, err
+	case "While":
+		w := While{Pos: pos}
+		if w.Cond, err = fromJSON(node.Cond); err != nil {
+			return nil, err
+		}
+		w.Body, err = fromJSONBlock(node.Body)
+		return w, err
+	case "Bad":
+		return Bad{Pos: pos}, nil
+	case "Var":
+		if node.Name == nil {
+			return nil, errors.New("Var without name")
+		}
+		return Var(*node.Name), nil
+	case "Val":
+		if node.Value == nil {
+			return nil, errors.New("Val without value")
+		}
+		return Val(*node.Value), nil
+	case "Arg":
+		if node.Number == nil || *node.Number < 0 {
+			return nil, errors.New("Arg without valid number")
+		}
+		return Arg(*node.Number), nil
+	}
+	return nil, fmt.Errorf("unknown type of expression %q", node.Type)
+}
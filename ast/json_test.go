@@ -0,0 +1,79 @@
+package ast_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+func TestJSON(t *testing.T) {
+	sources := []string{
+		`/* Greets
+ * whoever */
+fun greet(name) { "Hello " + name } greet(%0)`,
+		`f = fun() { "" }; g = fun(a, b) { a[b] }; f() + g("abc", "1")`,
+		`if (a == "" || b != "x" && c) { "then" } else if (d) { "\"quoted\"\n" } else { while (x) { x = "" } }`,
+		`x = "ok"; y = ; z = "also ok"`,
+	}
+	paths, err := filepath.Glob("../stringlang_programs/*.stringlang")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sources = append(sources, string(src))
+	}
+
+	for _, src := range sources {
+		// Parsing recovers from syntax errors to include Bad statements
+		e, _ := stringlang.ParseRecover([]byte(src))
+		data, err := ast.EncodeJSON(e)
+		if err != nil {
+			t.Fatalf("encoding %q: %v", src, err)
+		}
+		got, err := ast.DecodeJSON(data)
+		if err != nil {
+			t.Fatalf("decoding %s: %v", data, err)
+		}
+		if !reflect.DeepEqual(got, e) {
+			t.Errorf("decoding %s: got %#v, want %#v", data, got, e)
+		}
+	}
+}
+
+func TestDecodeJSONErrors(t *testing.T) {
+	tests := []struct {
+		json string
+		err  error
+	}{
+		{`{"version": 2, "expr": {"type": "Val", "value": ""}}`, ast.ErrJSONVersion},
+		{`{"version": 1, "expr": {"type": "Val"}}`, nil},
+		{`{"version": 1, "expr": {"type": "BinOp", "op": "-", "lhs": {"type": "Arg", "number": 0}, "rhs": {"type": "Arg", "number": 1}}}`, nil},
+		{`{"version": 1, "expr": {"type": "Lambda", "code": {"type": "Var", "name": "x"}}}`, nil},
+		{`{"version": 1, "expr": {"type": "Call", "fn": {"type": "Var", "name": "f"}, "args": [null]}}`, nil},
+		{`{"version": 1, "expr": {"type": "Unknown"}}`, nil},
+		{`{"version": 1, "expr": {"type": "While", "cond": {"type": "Var", "name": "x"}, "body": {"type": "Val", "value": ""}}}`, nil},
+		{`{"version": 1, "expr": {"type": "IfElse", "cond": {"type": "Var", "name": "x"}, "then": {"type": "Var", "name": "y"}, "else": {"type": "Block"}}}`, nil},
+		{`{"version": 1, "expr": {"type": "IfElse", "cond": {"type": "Var", "name": "x"}, "then": {"type": "Block"}, "else": {"type": "Var", "name": "y"}}}`, nil},
+		{`{"version": 1, "expr": {"type": "IfElse", "cond": {"type": "Var", "name": "x"}, "then": {"type": "Block"}}}`, nil},
+		{`{"version": 1, "expr": {"type": "Program", "code": {"type": "Block", "exprs": [{"type": "Program", "code": {"type": "Block"}}]}}}`, nil},
+		{`{"version": 1, "expr": {"type": "Assn", "name": "x", "expr": {"type": "Block"}}}`, nil},
+		{`{"version": 1, "expr": {"type": "Block", "exprs": [{"type": "Block"}]}}`, nil},
+	}
+	for _, tt := range tests {
+		e, err := ast.DecodeJSON([]byte(tt.json))
+		if err == nil {
+			t.Errorf("decoding %s: got %#v, want error", tt.json, e)
+		} else if tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("decoding %s: got error %v, want %v", tt.json, err, tt.err)
+		}
+	}
+}
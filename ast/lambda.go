@@ -8,12 +8,7 @@ import (
 type Lambda struct {
 	Params []string
 	Code   Block
-}
-
-func NewLambda(ps, b Attrib) (Expr, error) {
-	params := ps.([]string)
-	code := b.(Block)
-	return Lambda{Params: params, Code: code}, nil
+	Pos    Pos // Position of the "fun" keyword
 }
 
 func (l Lambda) Eval(c *Context) Val {
@@ -34,7 +29,25 @@ func (l Lambda) Eval(c *Context) Val {
 	l.Code = append(captures, l.Code...)
 	// We are evaluating the lambda itself, not calling it, hence we must return the string-value of a lambda
 	// For calling, see Lambda.Call
-	return Val(l.String())
+	src := l.String()
+	if c.canonical {
+		src = canonicalLambda(l, nil).String()
+	}
+	if !c.fits(int64(len(src))) {
+		return ""
+	}
+	if c.lambdas != nil {
+		// Calling the lambda parses its source again, which loses the positions of its code. Hooks (e.g. debuggers)
+		// want to see the original positions though, hence remember the lambda to call it instead.
+		c.lambdas[Val(src)] = l
+	}
+	return Val(src)
+}
+
+// SetCanonicalLambdas sets whether lambdas evaluate to their canonical source (see Canonical) instead of the source
+// they were written as. Then alpha-equivalent lambdas, e.g. fun(a) { a } and fun(b) { b }, are equal values.
+func (c *Context) SetCanonicalLambdas(canonical bool) {
+	c.canonical = canonical
 }
 
 func (l Lambda) String() string {
@@ -0,0 +1,68 @@
+package ast
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// EncodeList returns the canonical encoding of a list of strings as a single StringLang value: the elements as
+// double-quoted, escaped strings (like StringLang string literals), separated by ", " and enclosed in brackets,
+// e.g. ["a", "b \"c\""]. The empty list is [].
+func EncodeList(els []string) string {
+	quoted := make([]string, len(els))
+	for i, el := range els {
+		quoted[i] = strconv.Quote(el)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// DecodeList parses a list encoded by EncodeList, whitespace around elements is ignored
+func DecodeList(s string) ([]string, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return nil, errors.New("list must be enclosed in [ and ]")
+	}
+	rest := strings.TrimSpace(s[1 : len(s)-1])
+	els := []string{}
+	for rest != "" {
+		end := quotedPrefixLen(rest)
+		if end < 0 {
+			return nil, errors.New("list element must be a double-quoted string: " + rest)
+		}
+		el, err := strconv.Unquote(rest[:end])
+		if err != nil {
+			return nil, err
+		}
+		els = append(els, el)
+
+		rest = strings.TrimSpace(rest[end:])
+		if rest == "" {
+			break
+		}
+		if !strings.HasPrefix(rest, ",") {
+			return nil, errors.New("list elements must be separated by ',': " + rest)
+		}
+		rest = strings.TrimSpace(rest[1:])
+		if rest == "" {
+			return nil, errors.New("list must not end with ','")
+		}
+	}
+	return els, nil
+}
+
+// quotedPrefixLen returns the length of the double-quoted string s starts with, or -1 if it doesn't start with one
+func quotedPrefixLen(s string) int {
+	if !strings.HasPrefix(s, `"`) {
+		return -1
+	}
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1
+		}
+	}
+	return -1
+}
@@ -0,0 +1,93 @@
+package ast
+
+// GoStackframeEstimate is the number of bytes charged for every frame of a function call, to account for the Go
+// stackframes and bookkeeping the interpreter needs for it
+const GoStackframeEstimate = 8 * 1024
+
+// SetMaxMemory sets the maximum number of bytes an evaluation may use, a negative size disables the limit.
+// All values held by the program count towards the limit: variables of every frame, intermediate results
+// like the operands of a concatenation, arguments of calls and the frames themselves.
+func (c *Context) SetMaxMemory(sz int64) {
+	c.MaxMemory = sz
+	c.MaxStackSize = sz
+}
+
+// SetMaxStackSize sets the maximum number of bytes an evaluation may use.
+//
+// Deprecated: Use SetMaxMemory instead.
+func (c *Context) SetMaxStackSize(sz int64) {
+	c.SetMaxMemory(sz)
+}
+
+// memoryLimit returns the smaller one of MaxMemory and the deprecated MaxStackSize, ignoring negative ones. It's
+// negative if neither is set.
+func (c *Context) memoryLimit() int64 {
+	if c.MaxStackSize < 0 || (c.MaxMemory >= 0 && c.MaxMemory < c.MaxStackSize) {
+		return c.MaxMemory
+	}
+	return c.MaxStackSize
+}
+
+// MemoryUsage returns the number of bytes the current evaluation is using
+func (c *Context) MemoryUsage() int64 {
+	return c.state().mem
+}
+
+// charge reserves n bytes for the current evaluation. If that would exceed MaxMemory, charge aborts the evaluation
+// with ErrMemoryLimitExceeded instead and returns false, as it does if the evaluation was already aborted.
+func (c *Context) charge(n int64) bool {
+	s := c.state()
+	if s.err != nil {
+		return false
+	}
+	if max := c.memoryLimit(); max >= 0 && n > 0 && s.mem+n > max {
+		c.abort(ErrMemoryLimitExceeded)
+		return false
+	}
+	s.mem += n
+	return true
+}
+
+// release returns n bytes reserved by charge
+func (c *Context) release(n int64) {
+	c.state().mem -= n
+}
+
+// fits returns whether a new value of n bytes could be created, without reserving the space for it
+func (c *Context) fits(n int64) bool {
+	if !c.charge(n) {
+		return false
+	}
+	c.release(n)
+	return true
+}
+
+// hold reserves the space of v while it is being kept around as an intermediate result
+func (c *Context) hold(v Val) bool {
+	return c.charge(int64(len(v)))
+}
+
+// unhold releases the space reserved by hold
+func (c *Context) unhold(v Val) {
+	c.release(int64(len(v)))
+}
+
+// assign sets variable v to val, charging for the difference in size to its previous value
+func (c *Context) assign(v Var, val Val) bool {
+	if !c.charge(sizeDelta(c.VariableMap, v, val)) {
+		return false
+	}
+	c.VariableMap[v] = val
+	return true
+}
+
+// sizeDelta returns by how many bytes assigning val to v would change CheckSize(vars)
+func sizeDelta(vars map[Var]Val, v Var, val Val) int64 {
+	delta := int64(len(val))
+	if old, ok := vars[v]; ok {
+		delta -= int64(len(old))
+	} else {
+		delta += int64(len(v))
+	}
+	return delta
+}
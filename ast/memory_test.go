@@ -0,0 +1,64 @@
+package ast_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+func TestMemoryLimit(t *testing.T) {
+	// Each value of %0 fits the limit on its own, two of them don't
+	arg := strings.Repeat("a", 60)
+	const limit = 100
+	tests := []struct {
+		name    string
+		src     string
+		max     int64 // Limit to use instead of limit if non-zero
+		exceeds bool
+	}{
+		{"variables", `x = %0; y = %0`, 0, true},
+		{"variables reassigned", `x = %0; x = %0; x`, 0, false},
+		{"concatenation", `%0 + %0`, 0, true},
+		{"concatenation operands", `%0 + f(x = %0)`, 0, true},
+		{"index", `%0["0"]`, 0, false},
+		{"indexed value", `%0[f(x = %0)]`, 0, true},
+		{"arguments", `f(%0, "")`, 0, false},
+		{"evaluated arguments", `f(%0, x = %0)`, 0, true},
+		{"frames", `fun g(n) { if (n == "xxxx") { "" } else { g(n + "x") } } g("")`, 8 * ast.GoStackframeEstimate, false},
+		{"frames exceeded", `fun g(n) { if (n == "xxxx") { "" } else { g(n + "x") } } g("")`, 3 * ast.GoStackframeEstimate, true},
+		{"frames of lambdas", `h = fun(n) { n }; k = fun(n) { h(n) }; l = fun(n) { k(n) }; m = fun(n) { l(n) }; m("")`, 8 * ast.GoStackframeEstimate, false},
+		{"frames of lambdas exceeded", `h = fun(n) { n }; k = fun(n) { h(n) }; l = fun(n) { k(n) }; m = fun(n) { l(n) }; m("")`, 3 * ast.GoStackframeEstimate, true},
+	}
+	for _, tt := range tests {
+		c := stringlang.NewContext([]string{arg}, map[string]func([]string) string{
+			"f": func(args []string) string { return "0" },
+		})
+		c.SetMaxMemory(limit)
+		if tt.max != 0 {
+			c.SetMaxMemory(tt.max)
+		}
+		_, err := c.EvalContext(context.Background(), parse(t, tt.src))
+		if tt.exceeds && err != ast.ErrMemoryLimitExceeded {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, ast.ErrMemoryLimitExceeded)
+		}
+		if !tt.exceeds && err != nil {
+			t.Errorf("%s: got error %v", tt.name, err)
+		}
+	}
+}
+
+func TestMaxStackSize(t *testing.T) {
+	c := stringlang.NewContext([]string{strings.Repeat("a", 60)}, nil)
+	c.MaxStackSize = 100
+	_, err := c.EvalContext(context.Background(), parse(t, `%0 + %0`))
+	if err != ast.ErrMemoryLimitExceeded {
+		t.Errorf("with MaxStackSize 100: got error %v, want %v", err, ast.ErrMemoryLimitExceeded)
+	}
+	c.SetMaxMemory(-1)
+	if _, err := c.EvalContext(context.Background(), parse(t, `%0 + %0`)); err != nil {
+		t.Errorf("with SetMaxMemory(-1): got error %v", err)
+	}
+}
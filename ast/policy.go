@@ -0,0 +1,106 @@
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrPolicyViolation is reported when a program does something the Policy of its Context forbids
+var ErrPolicyViolation = errors.New("policy violation")
+
+// EvalBuiltins are the names of the built-in functions which evaluate arbitrary source, see Policy.DisableEval
+var EvalBuiltins = []string{"eval", "eval_isolated"}
+
+// Policy restricts the capabilities of untrusted programs. It is checked statically before evaluating a Program
+// (see Context.CheckPolicy) as well as at runtime, which also covers code run by eval and lambdas.
+type Policy struct {
+	// AllowedBuiltins are the names of the built-in functions programs may call, nil allows all of them
+	AllowedBuiltins []string
+	// DisableEval forbids calling the built-in functions in EvalBuiltins
+	DisableEval bool
+	// DisableDynamicCalls forbids calling values as lambdas, i.e. everything but named user-defined and built-in
+	// functions
+	DisableDynamicCalls bool
+	// MaxOutputSize is the maximum length of the result of an evaluation in bytes, 0 for no limit
+	MaxOutputSize int
+}
+
+// SetPolicy restricts all further evaluations using c to p, nil removes all restrictions
+func (c *Context) SetPolicy(p *Policy) {
+	c.Policy = p
+}
+
+// CheckPolicy checks statically whether prog would violate the Policy of c when evaluated
+func (c *Context) CheckPolicy(prog Program) error {
+	if c.Policy == nil {
+		return nil
+	}
+	info := prog.Info()
+
+	// Calls of names refer to built-in functions before variables, hence names of variables are built-in functions
+	// as well if c knows them as such
+	builtins := SetFrom(info.Builtins...)
+	for name := range calledNames(prog) {
+		if _, ok := c.FunctionMap[name]; ok {
+			builtins.Add(name)
+		}
+	}
+	for _, f := range prog.Funcs {
+		delete(builtins, f.Identifier)
+	}
+	forbidden := []string{}
+	for _, name := range builtins.Sorted() {
+		// Functions the context already knows from previous evaluations (e.g. in the REPL) aren't built-in
+		if _, ok := c.UserFunctionMap[name]; ok {
+			continue
+		}
+		if err := c.Policy.checkBuiltin(name); err != nil {
+			forbidden = append(forbidden, name)
+		}
+	}
+	if len(forbidden) > 0 {
+		return fmt.Errorf("%w: built-in functions not allowed: %s", ErrPolicyViolation, strings.Join(forbidden, ", "))
+	}
+	if info.DynamicCalls && c.Policy.DisableDynamicCalls {
+		return fmt.Errorf("%w: dynamic calls of lambdas are not allowed", ErrPolicyViolation)
+	}
+	return nil
+}
+
+// checkBuiltin returns an error if the policy forbids calling the built-in function name
+func (p *Policy) checkBuiltin(name string) error {
+	if p == nil {
+		return nil
+	}
+	if p.DisableEval {
+		for _, evalName := range EvalBuiltins {
+			if name == evalName {
+				return fmt.Errorf("%w: %s is disabled", ErrPolicyViolation, name)
+			}
+		}
+	}
+	if p.AllowedBuiltins == nil {
+		return nil
+	}
+	for _, allowed := range p.AllowedBuiltins {
+		if name == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: built-in function %s is not allowed", ErrPolicyViolation, name)
+}
+
+func (p *Policy) checkDynamicCall() error {
+	if p != nil && p.DisableDynamicCalls {
+		return fmt.Errorf("%w: dynamic calls of lambdas are not allowed", ErrPolicyViolation)
+	}
+	return nil
+}
+
+func (p *Policy) checkOutput(res Val) error {
+	if p != nil && p.MaxOutputSize > 0 && len(res) > p.MaxOutputSize {
+		return fmt.Errorf("%w: output of %d bytes exceeds the maximum of %d", ErrPolicyViolation, len(res), p.MaxOutputSize)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package ast_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+func TestPolicy(t *testing.T) {
+	upper := ast.PlainBuiltin("upper", func(args []string) string { return strings.ToUpper(args[0]) })
+	lower := ast.PlainBuiltin("lower", func(args []string) string { return strings.ToLower(args[0]) })
+	onlyUpper := &ast.Policy{AllowedBuiltins: []string{"upper"}}
+	noEval := &ast.Policy{DisableEval: true}
+	noDynamic := &ast.Policy{DisableDynamicCalls: true}
+	tests := []struct {
+		name   string
+		policy *ast.Policy
+		src    string
+		// static is whether CheckPolicy reports a violation, runtime whether evaluating the code without checking
+		// it statically does
+		static, runtime bool
+	}{
+		{"allowed builtin", onlyUpper, `upper("a")`, false, false},
+		{"forbidden builtin", onlyUpper, `lower("a")`, true, true},
+		{"forbidden builtin in function", onlyUpper, `fun f() { lower("a") } "b"`, true, false},
+		{"forbidden builtin in lambda", onlyUpper, `f = fun() { lower("a") }; f()`, true, true},
+		{"shadowed builtin", onlyUpper, `fun lower(s) { s } lower("a")`, false, false},
+		{"builtin named like a variable", onlyUpper, `lower = "x"; lower("a")`, true, true},
+		{"aliased builtin", onlyUpper, `secret("a")`, true, true},
+		{"no allow-list", &ast.Policy{}, `lower(upper("a"))`, false, false},
+		{"eval", noEval, `eval("\"a\"")`, true, true},
+		{"eval_isolated", noEval, `eval_isolated("\"a\"")`, true, true},
+		{"eval named like a variable", noEval, `eval = "x"; eval("\"a\"")`, true, true},
+		{"eval allowed", &ast.Policy{AllowedBuiltins: []string{"eval"}}, `eval("\"a\"")`, false, false},
+		{"forbidden builtin in eval", &ast.Policy{AllowedBuiltins: []string{"eval"}}, `eval("lower(\"a\")")`,
+			false, true},
+		{"dynamic call", noDynamic, `f = fun() { "a" }; f()`, true, true},
+		{"named call", noDynamic, `fun f() { "a" } f() + upper("b")`, false, false},
+		{"output within limit", &ast.Policy{MaxOutputSize: 3}, `"abc"`, false, false},
+		{"output too large", &ast.Policy{MaxOutputSize: 3}, `"ab" + "cd"`, false, true},
+	}
+	for _, tt := range tests {
+		prog := parse(t, tt.src).(ast.Program)
+		newContext := func() *ast.Context {
+			c := stringlang.NewContextBuiltins(nil, ast.EvalBuiltin(), ast.EvalIsolatedBuiltin(), upper, lower)
+			// Registered under a different name than its own
+			c.FunctionMap["secret"] = upper
+			c.SetPolicy(tt.policy)
+			return c
+		}
+
+		if err := newContext().CheckPolicy(prog); errors.Is(err, ast.ErrPolicyViolation) != tt.static {
+			t.Errorf("%s: CheckPolicy got error %v, want violation: %v", tt.name, err, tt.static)
+		}
+
+		// Evaluating functions and code separately skips the static check
+		c := newContext()
+		for _, f := range prog.Funcs {
+			c.UserFunctionMap[f.Identifier] = f
+		}
+		if _, err := c.EvalContext(context.Background(), prog.Code); errors.Is(err, ast.ErrPolicyViolation) != tt.runtime {
+			t.Errorf("%s: evaluating got error %v, want violation: %v", tt.name, err, tt.runtime)
+		}
+
+		// Both together when evaluating the program
+		_, err := newContext().EvalContext(context.Background(), prog)
+		if errors.Is(err, ast.ErrPolicyViolation) != (tt.static || tt.runtime) {
+			t.Errorf("%s: evaluating the program got error %v, want violation: %v", tt.name, err,
+				tt.static || tt.runtime)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package ast
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Pos is a position in the source code of a program. Line and Column start at 1, the zero Pos is unknown.
+type Pos struct {
+	Offset int // Byte offset, starting at 0
+	Line   int
+	Column int
+}
+
+// IsValid returns whether p is a known position
+func (p Pos) IsValid() bool {
+	return p.Line > 0
+}
+
+func (p Pos) String() string {
+	if !p.IsValid() {
+		return "-"
+	}
+	return strconv.Itoa(p.Line) + ":" + strconv.Itoa(p.Column)
+}
+
+// PosOf returns the position of e if it has one, i.e. of the keyword or operator it starts with or is built around.
+// Variables, values and arguments have no position.
+func PosOf(e Expr) Pos {
+	switch val := e.(type) {
+	case Assn:
+		return val.Pos
+	case Call:
+		return val.Pos
+	case BinOp:
+		return val.Pos
+	case IfElse:
+		return val.Pos
+	case While:
+		return val.Pos
+	case Index:
+		return val.Pos
+	case Lambda:
+		return val.Pos
+	case Bad:
+		return val.Pos
+	}
+	return Pos{}
+}
+
+// DocComment returns the text of the comment directly preceding offset in src, i.e. separated from it by nothing but
+// whitespace without blank lines. Decorations like leading '*' on every line and common indentation are removed.
+func DocComment(src []byte, offset int) string {
+	if offset > len(src) {
+		return ""
+	}
+	before := string(src[:offset])
+	trimmed := strings.TrimRight(before, " \t\r\n")
+	if strings.Count(before[len(trimmed):], "\n") > 1 || !strings.HasSuffix(trimmed, "*/") {
+		return ""
+	}
+	start := strings.LastIndex(trimmed[:len(trimmed)-2], "/*")
+	if start < 0 {
+		return ""
+	}
+	return cleanComment(trimmed[start+2 : len(trimmed)-2])
+}
+
+func cleanComment(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t")
+	}
+	// Drop blank lines around the text
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+
+	// Remove leading '*' if all non-blank lines have one
+	starred := true
+	for _, l := range lines {
+		t := strings.TrimSpace(l)
+		if t != "" && !strings.HasPrefix(t, "*") {
+			starred = false
+		}
+	}
+	if starred {
+		for i, l := range lines {
+			lines[i] = strings.TrimPrefix(strings.TrimLeft(l, " \t"), "*")
+		}
+	}
+
+	// Remove common indentation of non-blank lines
+	indent := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent < 0 {
+		// Only decorations, e.g. "/***/"
+		return ""
+	}
+	for i, l := range lines {
+		if len(l) >= indent {
+			lines[i] = l[indent:]
+		} else {
+			lines[i] = ""
+		}
+	}
+	return strings.Join(lines, "\n")
+}
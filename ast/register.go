@@ -0,0 +1,233 @@
+package ast
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	callContextType = reflect.TypeOf((*CallContext)(nil))
+	goContextType   = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType       = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Register makes the Go function fn available to programs as built-in function name, see ReflectBuiltin
+func (c *Context) Register(name string, fn interface{}) error {
+	b, err := ReflectBuiltin(name, fn)
+	if err != nil {
+		return err
+	}
+	c.AddBuiltin(b)
+	return nil
+}
+
+// ReflectBuiltin adapts the Go function fn to a Builtin using reflection.
+//
+// The parameters of fn may be strings, booleans, integers, floats and slices of those, fn may also be variadic.
+// Arguments are converted from their StringLang values, where booleans follow the rules of BoolOf and slices use the
+// encoding of EncodeList. Optionally, the first parameter of fn may be a *CallContext or a context.Context.
+//
+// fn may return nothing, a single value of a type allowed for parameters, an error or such a value followed by an
+// error. Results are converted back to StringLang values, and both conversion errors and errors returned by fn abort
+// the evaluation with a RuntimeError.
+//
+// The returned Builtin documents the signature of fn and is not pure, both can be changed on the returned value.
+func ReflectBuiltin(name string, fn interface{}) (BuiltinFunc, error) {
+	fnVal := reflect.ValueOf(fn)
+	if !fnVal.IsValid() {
+		return BuiltinFunc{}, fmt.Errorf("%s: expected a function, got nil", name)
+	}
+	fnType := fnVal.Type()
+	if fnType.Kind() != reflect.Func {
+		return BuiltinFunc{}, fmt.Errorf("%s: expected a function, got %v", name, fnType)
+	}
+	if fnVal.IsNil() {
+		return BuiltinFunc{}, fmt.Errorf("%s: expected a function, got a nil %v", name, fnType)
+	}
+
+	// Leading CallContext or context.Context
+	firstParam := 0
+	if fnType.NumIn() > 0 && (fnType.In(0) == callContextType || fnType.In(0) == goContextType) {
+		firstParam = 1
+	}
+
+	params := make([]reflect.Type, 0, fnType.NumIn()-firstParam)
+	for i := firstParam; i < fnType.NumIn(); i++ {
+		t := fnType.In(i)
+		if fnType.IsVariadic() && i == fnType.NumIn()-1 {
+			t = t.Elem()
+		}
+		if !isConvertible(t) {
+			return BuiltinFunc{}, fmt.Errorf("%s: unsupported parameter type %v", name, t)
+		}
+		params = append(params, t)
+	}
+
+	returnsErr := fnType.NumOut() > 0 && fnType.Out(fnType.NumOut()-1) == errorType
+	numResults := fnType.NumOut()
+	if returnsErr {
+		numResults--
+	}
+	if numResults > 1 || (numResults == 1 && !isConvertible(fnType.Out(0))) {
+		return BuiltinFunc{}, fmt.Errorf("%s: unsupported results %v", name, fnType)
+	}
+
+	min, max := len(params), len(params)
+	if fnType.IsVariadic() {
+		min, max = len(params)-1, -1
+	}
+
+	call := func(cc *CallContext, args []string) (string, error) {
+		in := make([]reflect.Value, 0, firstParam+len(args))
+		if firstParam == 1 {
+			if fnType.In(0) == callContextType {
+				in = append(in, reflect.ValueOf(cc))
+			} else {
+				in = append(in, reflect.ValueOf(cc.Context))
+			}
+		}
+		for i, arg := range args {
+			t := params[len(params)-1]
+			if i < len(params) {
+				t = params[i]
+			}
+			v, err := fromString(arg, t)
+			if err != nil {
+				return "", fmt.Errorf("argument %d: %v", i, err)
+			}
+			in = append(in, v)
+		}
+
+		out := fnVal.Call(in)
+		if returnsErr {
+			if err, _ := out[len(out)-1].Interface().(error); err != nil {
+				return "", err
+			}
+		}
+		if numResults == 0 {
+			return "", nil
+		}
+		return toString(out[0]), nil
+	}
+
+	return BuiltinFunc{
+		Identifier:    name,
+		Min:           min,
+		Max:           max,
+		Documentation: signature(name, fnType, firstParam),
+		Fn:            call,
+	}, nil
+}
+
+// signature returns the Go-like signature of fn as called from StringLang, e.g. repeat(string, int) string
+func signature(name string, fnType reflect.Type, firstParam int) string {
+	params := make([]string, 0, fnType.NumIn())
+	for i := firstParam; i < fnType.NumIn(); i++ {
+		if fnType.IsVariadic() && i == fnType.NumIn()-1 {
+			params = append(params, "..."+fnType.In(i).Elem().String())
+		} else {
+			params = append(params, fnType.In(i).String())
+		}
+	}
+	results := make([]string, 0, fnType.NumOut())
+	for i := 0; i < fnType.NumOut(); i++ {
+		results = append(results, fnType.Out(i).String())
+	}
+
+	sig := name + "(" + strings.Join(params, ", ") + ")"
+	switch len(results) {
+	case 0:
+		return sig
+	case 1:
+		return sig + " " + results[0]
+	default:
+		return sig + " (" + strings.Join(results, ", ") + ")"
+	}
+}
+
+func isConvertible(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() != reflect.Slice && isConvertible(t.Elem())
+	}
+	return false
+}
+
+// fromString converts the StringLang value s to a Go value of type t
+func fromString(s string, t reflect.Type) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		v.SetBool(BoolOf(Val(s)))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, t.Bits())
+		if err != nil {
+			return v, conversionError(s, t)
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, t.Bits())
+		if err != nil {
+			return v, conversionError(s, t)
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, t.Bits())
+		if err != nil {
+			return v, conversionError(s, t)
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		els, err := DecodeList(s)
+		if err != nil {
+			return v, fmt.Errorf("cannot convert %q to %v: %v", s, t, err)
+		}
+		v = reflect.MakeSlice(t, len(els), len(els))
+		for i, el := range els {
+			elV, err := fromString(el, t.Elem())
+			if err != nil {
+				return v, fmt.Errorf("element %d: %v", i, err)
+			}
+			v.Index(i).Set(elV)
+		}
+	default:
+		return v, errors.New("unsupported type " + t.String())
+	}
+	return v, nil
+}
+
+func conversionError(s string, t reflect.Type) error {
+	return fmt.Errorf("cannot convert %q to %v", s, t)
+}
+
+// toString converts the Go value v to a StringLang value
+func toString(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+	case reflect.Slice:
+		els := make([]string, v.Len())
+		for i := range els {
+			els[i] = toString(v.Index(i))
+		}
+		return EncodeList(els)
+	}
+	return v.String()
+}
@@ -0,0 +1,197 @@
+package ast_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+func TestRegister(t *testing.T) {
+	errEmpty := errors.New("empty")
+	funcs := map[string]interface{}{
+		"repeat": strings.Repeat,
+		"not":    func(b bool) bool { return !b },
+		"add":    func(a, b int) int { return a + b },
+		"inc":    func(u uint8) uint8 { return u + 1 },
+		"half":   func(f float64) float64 { return f / 2 },
+		"count":  func(s []string) int { return len(s) },
+		"fields": strings.Fields,
+		"sum": func(xs []int) (sum int) {
+			for _, x := range xs {
+				sum += x
+			}
+			return sum
+		},
+		"join": func(sep string, parts ...string) string { return strings.Join(parts, sep) },
+		"depth": func(cc *ast.CallContext, s string) string {
+			return s + ":" + strconv.Itoa(cc.Limits().MaxCallDepth)
+		},
+		"done": func(ctx context.Context) bool { return ctx.Err() != nil },
+		"nonempty": func(s string) (string, error) {
+			if s == "" {
+				return "", errEmpty
+			}
+			return s, nil
+		},
+		"nothing": func() {},
+	}
+	tests := []struct {
+		src  string
+		want string
+		err  bool
+	}{
+		{`repeat("ab", "3")`, "ababab", false},
+		{`repeat("ab")`, "", true},
+		{`not("")`, "true", false},
+		{`not("x")`, "false", false},
+		{`add("2", "-3")`, "-1", false},
+		{`add("2", "x")`, "", true},
+		{`inc("254")`, "255", false},
+		{`inc("255" + "0")`, "", true},
+		{`half("3")`, "1.5", false},
+		{`half("three")`, "", true},
+		{`count("[\"a\", \"b,c\"]")`, "2", false},
+		{`count("a, b")`, "", true},
+		{`fields(" a  b ")`, `["a", "b"]`, false},
+		{`fields("")`, `[]`, false},
+		{`sum("[\"1\", \"2\", \"3\"]")`, "6", false},
+		{`sum("[\"1\", \"x\"]")`, "", true},
+		{`join("-")`, "", false},
+		{`join("-", "a", "b", "c")`, "a-b-c", false},
+		{`join()`, "", true},
+		{`depth("d")`, "d:5", false},
+		{`done()`, "false", false},
+		{`nonempty("x")`, "x", false},
+		{`nonempty("")`, "", true},
+		{`nothing()`, "", false},
+	}
+	c := stringlang.NewContext(nil, nil)
+	c.SetMaxCallDepth(5)
+	for name, fn := range funcs {
+		if err := c.Register(name, fn); err != nil {
+			t.Fatalf("registering %s: %v", name, err)
+		}
+	}
+	for _, tt := range tests {
+		got, err := c.EvalContext(context.Background(), parse(t, tt.src))
+		var rerr *ast.RuntimeError
+		if tt.err && !errors.As(err, &rerr) {
+			t.Errorf("%s: got %q, %v, want a RuntimeError", tt.src, got, err)
+		}
+		if !tt.err && (err != nil || string(got) != tt.want) {
+			t.Errorf("%s: got %q, %v, want %q", tt.src, got, err, tt.want)
+		}
+	}
+	if _, err := c.EvalContext(context.Background(), parse(t, `nonempty("")`)); !errors.Is(err, errEmpty) {
+		t.Errorf("got error %v, want the error returned by the function", err)
+	}
+}
+
+func TestReflectBuiltinSignature(t *testing.T) {
+	tests := []struct {
+		fn       interface{}
+		doc      string
+		min, max int
+	}{
+		{strings.Repeat, "repeat(string, int) string", 2, 2},
+		{func(cc *ast.CallContext, sep string, parts ...string) (string, error) { return "", nil },
+			"repeat(string, ...string) (string, error)", 1, -1},
+		{func() {}, "repeat()", 0, 0},
+	}
+	for _, tt := range tests {
+		b, err := ast.ReflectBuiltin("repeat", tt.fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Doc() != tt.doc || b.MinArity() != tt.min || b.MaxArity() != tt.max || b.Pure() {
+			t.Errorf("got %q with arity %d to %d, want %q with arity %d to %d, not pure", b.Doc(), b.MinArity(),
+				b.MaxArity(), tt.doc, tt.min, tt.max)
+		}
+	}
+}
+
+func TestArity(t *testing.T) {
+	tests := []struct {
+		min, max int
+		want     string
+	}{
+		{0, 0, "0 arguments"},
+		{1, 1, "1 argument"},
+		{2, 2, "2 arguments"},
+		{1, 2, "1 to 2 arguments"},
+		{0, -1, "at least 0 arguments"},
+		{1, -1, "at least 1 argument"},
+		{2, -1, "at least 2 arguments"},
+	}
+	for _, tt := range tests {
+		if got := ast.Arity(ast.BuiltinFunc{Min: tt.min, Max: tt.max}); got != tt.want {
+			t.Errorf("%d to %d: got %q, want %q", tt.min, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestRegisterErrors(t *testing.T) {
+	var nilFunc func(string) string
+	invalid := []interface{}{
+		nil,
+		nilFunc,
+		"not a function",
+		func(m map[string]string) string { return "" },
+		func(s [][]string) string { return "" },
+		func() (string, string) { return "", "" },
+		func() chan string { return nil },
+		func() (error, string) { return nil, "" },
+	}
+	c := stringlang.NewContext(nil, nil)
+	for _, fn := range invalid {
+		if err := c.Register("f", fn); err == nil {
+			t.Errorf("registering %T: got no error", fn)
+		}
+	}
+	if len(c.FunctionMap) != 0 {
+		t.Errorf("registered %d functions despite errors", len(c.FunctionMap))
+	}
+}
+
+func TestEncodeDecodeList(t *testing.T) {
+	lists := [][]string{
+		{},
+		{""},
+		{"a", "b"},
+		{`"quoted", with comma`, "new\nline", `back\slash`, "[brackets]", "ünïcode"},
+	}
+	for _, list := range lists {
+		encoded := ast.EncodeList(list)
+		decoded, err := ast.DecodeList(encoded)
+		if err != nil || !reflect.DeepEqual(decoded, list) {
+			t.Errorf("decoding %s: got %q, %v, want %q", encoded, decoded, err, list)
+		}
+	}
+	if got := ast.EncodeList([]string{"a", `b "c"`}); got != `["a", "b \"c\""]` {
+		t.Errorf("got encoding %s", got)
+	}
+
+	valid := map[string][]string{
+		` [ "a" ,"b"  ] `: {"a", "b"},
+		`[]`:              {},
+		`[ ]`:             {},
+	}
+	for s, want := range valid {
+		got, err := ast.DecodeList(s)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("decoding %s: got %q, %v, want %q", s, got, err, want)
+		}
+	}
+	invalid := []string{``, `"a"`, `["a"`, `[a]`, `["a",]`, `["a" "b"]`, `["a]`, `["\q"]`, `[,]`}
+	for _, s := range invalid {
+		if got, err := ast.DecodeList(s); err == nil {
+			t.Errorf("decoding %s: got %q, want error", s, got)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package ast
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// ErrReplayDiverged is reported when a replayed evaluation calls different built-in functions than the recorded one
+var ErrReplayDiverged = errors.New("replay diverged from recording")
+
+// Recording is the log of all nondeterministic built-in function calls of an evaluation, see Context.RecordTo. It can
+// be stored as JSON, e.g. alongside a bug report, and replayed using Context.ReplayFrom.
+type Recording struct {
+	Seed  int64          `json:"seed"` // Seed of the random number generator of the recorded Context
+	Calls []RecordedCall `json:"calls"`
+}
+
+// RecordedCall is a single call of a nondeterministic built-in function
+type RecordedCall struct {
+	Func   string   `json:"func"`
+	Args   []string `json:"args"`
+	Result string   `json:"result"`
+	Err    string   `json:"err,omitempty"` // Message of the error the call returned, if any
+}
+
+// replayState is the position in the Recording being replayed
+type replayState struct {
+	rec  *Recording
+	next int
+}
+
+// SetSeed makes the random number generator built-in functions get using CallContext.Rand deterministic
+func (c *Context) SetSeed(seed int64) {
+	c.seed = seed
+	c.rng = rand.New(rand.NewSource(seed))
+}
+
+// Seed returns the seed of the random number generator of c
+func (c *Context) Seed() int64 {
+	return c.seed
+}
+
+// SetClock sets the clock built-in functions get using CallContext.Now, nil uses time.Now
+func (c *Context) SetClock(clock func() time.Time) {
+	c.clock = clock
+}
+
+// RecordTo appends all calls of nondeterministic built-in functions of further evaluations using c to rec, together
+// with their results. A built-in function is nondeterministic unless it is pure, calls of the EvalBuiltins aren't
+// recorded either, as the calls they evaluate are recorded themselves.
+func (c *Context) RecordTo(rec *Recording) {
+	rec.Seed = c.seed
+	c.recording = rec
+	c.replay = nil
+}
+
+// ReplayFrom makes further evaluations using c return the results recorded in rec instead of calling nondeterministic
+// built-in functions, and seeds c like the recorded Context. If the evaluation calls different functions or
+// arguments than recorded, it is aborted with ErrReplayDiverged.
+func (c *Context) ReplayFrom(rec *Recording) {
+	c.SetSeed(rec.Seed)
+	c.replay = &replayState{rec: rec}
+	c.recording = nil
+}
+
+// Rand returns the random number generator of the calling evaluation, built-in functions should use it instead of
+// the global one of package math/rand, so that evaluations can be reproduced using Context.SetSeed
+func (cc *CallContext) Rand() *rand.Rand {
+	return cc.frame.rng
+}
+
+// Now returns the current time according to the clock of the calling evaluation, see Context.SetClock
+func (cc *CallContext) Now() time.Time {
+	if cc.frame.clock == nil {
+		return time.Now()
+	}
+	return cc.frame.clock()
+}
+
+// isDeterministic returns whether calls of b needn't be recorded
+func isDeterministic(b Builtin) bool {
+	if b.Pure() {
+		return true
+	}
+	for _, name := range EvalBuiltins {
+		if b.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// callRecorded calls b, recording or replaying the call if c is recording or replaying
+func callRecorded(c *Context, cc *CallContext, b Builtin, args []string) (string, error) {
+	if (c.recording == nil && c.replay == nil) || isDeterministic(b) {
+		return b.Call(cc, args)
+	}
+	if c.replay != nil {
+		return c.replay.nextCall(b.Name(), args)
+	}
+
+	res, err := b.Call(cc, args)
+	call := RecordedCall{Func: b.Name(), Args: make([]string, len(args)), Result: res}
+	copy(call.Args, args)
+	if err != nil {
+		call.Err = err.Error()
+	}
+	c.recording.Calls = append(c.recording.Calls, call)
+	return res, err
+}
+
+func (r *replayState) nextCall(name string, args []string) (string, error) {
+	if r.next >= len(r.rec.Calls) {
+		return "", fmt.Errorf("%w: unexpected call of %s after %d recorded calls", ErrReplayDiverged, name, r.next)
+	}
+	call := r.rec.Calls[r.next]
+	if call.Func != name || !equalStrings(call.Args, args) {
+		return "", fmt.Errorf("%w: call %d is %s with %d arguments, recorded was %s with %d arguments",
+			ErrReplayDiverged, r.next+1, name, len(args), call.Func, len(call.Args))
+	}
+	r.next++
+	if call.Err != "" {
+		return call.Result, errors.New(call.Err)
+	}
+	return call.Result, nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package ast_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+// nondeterministic returns built-in functions whose results depend on the seed, the clock and calls is the number of
+// calls of external so far
+func nondeterministic(calls *int) []ast.Builtin {
+	return []ast.Builtin{
+		ast.BuiltinFunc{Identifier: "random", Min: 0, Max: 0,
+			Fn: func(cc *ast.CallContext, args []string) (string, error) {
+				return strconv.Itoa(cc.Rand().Intn(1000000)), nil
+			},
+		},
+		ast.BuiltinFunc{Identifier: "now", Min: 0, Max: 0,
+			Fn: func(cc *ast.CallContext, args []string) (string, error) {
+				return strconv.FormatInt(cc.Now().UnixNano(), 10), nil
+			},
+		},
+		ast.BuiltinFunc{Identifier: "external", Min: 1, Max: 1,
+			Fn: func(cc *ast.CallContext, args []string) (string, error) {
+				*calls++
+				if args[0] == "fail" {
+					return "partial", errors.New("external failed")
+				}
+				return args[0] + strconv.Itoa(*calls), nil
+			},
+		},
+	}
+}
+
+func TestSeed(t *testing.T) {
+	e := parse(t, `random() + " " + random()`)
+	for _, seed := range []int64{0, 1, -5} {
+		var results [2]ast.Val
+		for i := range results {
+			var calls int
+			c := stringlang.NewContextBuiltins(nil, nondeterministic(&calls)...)
+			c.SetSeed(seed)
+			res, err := c.EvalContext(context.Background(), e)
+			if err != nil {
+				t.Fatal(err)
+			}
+			results[i] = res
+		}
+		if results[0] != results[1] {
+			t.Errorf("seed %d: got %q and %q", seed, results[0], results[1])
+		}
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	const src = `fun f(x) { external(x) } r = random(); t = now(); r + t + f("a") + eval("external(\"b\")") + f(%0)`
+	var calls int
+	c := stringlang.NewContextBuiltins([]string{"c"}, append(nondeterministic(&calls), ast.EvalBuiltin())...)
+	c.SetClock(func() time.Time { return time.Unix(0, 42) })
+	rec := &ast.Recording{}
+	c.RecordTo(rec)
+	want, err := c.EvalContext(context.Background(), parse(t, src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.Calls) != 5 {
+		t.Fatalf("recorded %d calls, want 5: %+v", len(rec.Calls), rec.Calls)
+	}
+
+	// Replaying from JSON reproduces the result without calling the built-in functions or the clock
+	data, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replayed := &ast.Recording{}
+	if err := json.Unmarshal(data, replayed); err != nil {
+		t.Fatal(err)
+	}
+	calls = 0
+	c = stringlang.NewContextBuiltins([]string{"c"}, append(nondeterministic(&calls), ast.EvalBuiltin())...)
+	c.ReplayFrom(replayed)
+	got, err := c.EvalContext(context.Background(), parse(t, src))
+	if err != nil || got != want {
+		t.Errorf("replaying: got %q, %v, want %q", got, err, want)
+	}
+	if calls != 0 {
+		t.Errorf("replaying called built-in functions %d times", calls)
+	}
+
+	// Errors are replayed as well
+	calls = 0
+	c = stringlang.NewContextBuiltins(nil, nondeterministic(&calls)...)
+	rec = &ast.Recording{}
+	c.RecordTo(rec)
+	_, recordedErr := c.EvalContext(context.Background(), parse(t, `external("fail")`))
+	c = stringlang.NewContextBuiltins(nil, nondeterministic(&calls)...)
+	c.ReplayFrom(rec)
+	_, err = c.EvalContext(context.Background(), parse(t, `external("fail")`))
+	if recordedErr == nil || err == nil || err.Error() != recordedErr.Error() {
+		t.Errorf("replaying a failing call: got error %v, want %v", err, recordedErr)
+	}
+}
+
+func TestReplayDiverged(t *testing.T) {
+	rec := &ast.Recording{Seed: 0, Calls: []ast.RecordedCall{{Func: "external", Args: []string{"a"}, Result: "a1"}}}
+	tests := []struct {
+		src      string
+		diverges bool
+	}{
+		{`external("a")`, false},
+		{`"no calls"`, false},
+		{`external("b")`, true},
+		{`now()`, true},
+		{`external("a") + external("a")`, true},
+	}
+	for _, tt := range tests {
+		var calls int
+		c := stringlang.NewContextBuiltins(nil, nondeterministic(&calls)...)
+		c.ReplayFrom(rec)
+		_, err := c.EvalContext(context.Background(), parse(t, tt.src))
+		if errors.Is(err, ast.ErrReplayDiverged) != tt.diverges {
+			t.Errorf("%s: got error %v, want diverged: %v", tt.src, err, tt.diverges)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package ast_test
+
+import (
+	"testing"
+
+	"github.com/skius/stringlang/ast"
+)
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{`x = %1`, "\nx = %1"},
+		{`if (a) { "x" } else if (b) { "y" } else { "z" }`,
+			"\nif (a) {\n\t\"x\"\n} else if (b) {\n\t\"y\"\n} else {\n\t\"z\"\n}"},
+		{`if (a) { "x" } else { if (b) { "y" } else { "z" } }`,
+			"\nif (a) {\n\t\"x\"\n} else {\n\tif (b) {\n\t\t\"y\"\n\t} else {\n\t\t\"z\"\n\t}\n}"},
+		{`/***/ fun f() { "a" } f()`, "fun f() {\n\t\"a\"\n}\nf()"},
+	}
+	for _, tt := range tests {
+		e := parse(t, tt.src)
+		got := e.String()
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.src, got, tt.want)
+		}
+		if again := parse(t, got); !ast.Equal(again, e) {
+			t.Errorf("%s: parsing the printed program %q gives %#v, want %#v", tt.src, got, again, e)
+		}
+	}
+}
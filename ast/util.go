@@ -1,8 +1,6 @@
 package ast
 
 import (
-	"fmt"
-	"github.com/skius/stringlang/internal/frontend/token"
 	"sort"
 	"strings"
 )
@@ -18,37 +16,58 @@ func BoolOf(v Val) bool {
 	return v != "false" && v != ""
 }
 
-func HasSideEffects(e Expr) bool {
-	switch val := e.(type) {
-	case Program:
+// ExceedsDepth returns whether the expression tree of e is nested deeper than maxDepth. It never recurses deeper than
+// maxDepth itself, hence it is safe to use on untrusted programs before evaluating or printing them. A negative maxDepth
+// is no limit, hence never exceeded.
+func ExceedsDepth(e Expr, maxDepth int) bool {
+	if maxDepth < 0 {
+		return false
+	}
+	exceeded := false
+	Walk(depthVisitor{left: maxDepth, exceeded: &exceeded}, e)
+	return exceeded
+}
+
+// depthVisitor visits expressions until they are nested deeper than left
+type depthVisitor struct {
+	left     int
+	exceeded *bool
+}
+
+func (v depthVisitor) Visit(e Expr) Visitor {
+	if e == nil || *v.exceeded {
+		return nil
+	}
+	if v.left < 0 {
+		*v.exceeded = true
+		return nil
+	}
+	return depthVisitor{left: v.left - 1, exceeded: v.exceeded}
+}
+
+// HasSideEffects returns whether evaluating e may have side effects. Calls of the built-in functions named in pure
+// (see Context.PureFuncs) only have the side effects of their arguments, all other calls are assumed to have some.
+func HasSideEffects(e Expr, pure Set) bool {
+	if _, ok := e.(Program); ok {
 		panic("Program has side effects?")
-	case Block:
-		any := false
-		for _, e := range val {
-			if HasSideEffects(e) {
+	}
+	any := false
+	Inspect(e, func(e Expr) bool {
+		switch val := e.(type) {
+		case Assn:
+			any = true
+		case Call:
+			fnVar, ok := val.Fn.(Var)
+			if !ok || !pure.Contains(string(fnVar)) {
 				any = true
-				break
 			}
+		case Lambda:
+			// Evaluating a lambda merely creates it, its code runs when called
+			return false
 		}
-		return any
-	case Assn:
-		return true
-	case Var:
-		return false
-	case Val:
-		return false
-	case BinOp:
-		return HasSideEffects(val.Lhs) || HasSideEffects(val.Rhs)
-	case While:
-		return HasSideEffects(val.Cond) || HasSideEffects(val.Body)
-	case IfElse:
-		return HasSideEffects(val.Cond) || HasSideEffects(val.Then) || HasSideEffects(val.Else)
-	case Call:
-		return true
-	case Index:
-		return HasSideEffects(val.Source) || HasSideEffects(val.I)
-	}
-	return false
+		return !any
+	})
+	return any
 }
 
 func DefinedVars(e Expr) Set {
@@ -58,122 +77,78 @@ func DefinedVars(e Expr) Set {
 }
 
 func setDefs(expr Expr, defs Set) {
-	switch val := expr.(type) {
-	case Program:
-		setDefs(val.Code, defs)
-	case Block:
-		for _, e := range val {
-			setDefs(e, defs)
-		}
-	case Assn:
-		defs[string(val.V)] = struct{}{}
-		setDefs(val.E, defs)
-	case Var:
-		return
-	case Val:
-		return
-	case Arg:
-		return
-	case BinOp:
-		setDefs(val.Lhs, defs)
-		setDefs(val.Rhs, defs)
-	case While:
-		setDefs(val.Cond, defs)
-		setDefs(val.Body, defs)
-	case IfElse:
-		setDefs(val.Cond, defs)
-		setDefs(val.Then, defs)
-		setDefs(val.Else, defs)
-	case Call:
-		for _, e := range val.Args {
-			setDefs(e, defs)
-		}
-		// Because we allow arbitrary sources for a call, we need to take those into account
-		setDefs(val.Fn, defs)
-	case Index:
-		setDefs(val.Source, defs)
-		setDefs(val.I, defs)
-	case Lambda:
-		// A lambda defines no variables for its parent scope
-		return
+	if prog, ok := expr.(Program); ok {
+		expr = prog.Code
 	}
-	return
+	Inspect(expr, func(e Expr) bool {
+		switch val := e.(type) {
+		case Assn:
+			defs[string(val.V)] = struct{}{}
+		case Lambda:
+			// A lambda defines no variables for its parent scope
+			return false
+		}
+		return true
+	})
 }
 
+// UsedBeforeDefVars returns the variables e reads which it may not have assigned before, calls of the functions in
+// funcNames aren't reads. Like evaluation, it assumes that a BinOp's Rhs doesn't see the assignments of its Lhs.
 func UsedBeforeDefVars(e Expr, funcNames Set) Set {
 	used := make(Set)
-	setUsedBeforeDef(e, used, funcNames)
+	setUsedBeforeDef(e, used, funcNames, make(Set))
 	return used
 }
 
-func setUsedBeforeDef(expr Expr, used Set, funcNames Set) {
-	switch val := expr.(type) {
-	case Program:
-		newFuncNames := make(Set, len(val.Funcs))
-		for _, f := range val.Funcs {
-			newFuncNames.Union(SetFrom(f.Identifier))
-		}
-		setUsedBeforeDef(val.Code, used, newFuncNames.Union(funcNames))
-	case Block:
-		defs := make(Set)
-		tempUsed := used.Copy()
-		for _, e := range val {
-			setUsedBeforeDef(e, tempUsed, funcNames)
-			used.Union(tempUsed.Except(defs))
-			defs.Union(DefinedVars(e))
-		}
-	case Assn:
-		setUsedBeforeDef(val.E, used, funcNames)
-	case Var:
-		used[string(val)] = struct{}{}
-	case Val:
-		return
-	case Arg:
-		return
-	case BinOp:
-		setUsedBeforeDef(val.Lhs, used, funcNames)
-		setUsedBeforeDef(val.Rhs, used, funcNames)
-		// Lhs may have defined some variables for Rhs to use,
-		// but let's not, this way we can keep evaluation order ambiguous (if we want to)
-	case While:
-		setUsedBeforeDef(val.Cond, used, funcNames)
-		bodyUsed := UsedBeforeDefVars(val.Body, funcNames)
-		// Cond may have defined some variables for Body to use
-		used.Union(bodyUsed.Except(DefinedVars(val.Cond)))
-	case IfElse:
-		setUsedBeforeDef(val.Cond, used, funcNames)
-		thenUsed := used.Copy()
-		elseUsed := used.Copy()
-		setUsedBeforeDef(val.Then, thenUsed, funcNames)
-		setUsedBeforeDef(val.Else, elseUsed, funcNames)
-		// Cond may have defined some variables for Body to use
-		condDefs := DefinedVars(val.Cond)
-		used.Union(thenUsed.Union(elseUsed)).Except(condDefs)
-	case Call:
-		for _, e := range val.Args {
-			setUsedBeforeDef(e, used, funcNames)
-		}
-		// Because we allow arbitrary sources for a call, we need to take those into account
-		if variable, ok := val.Fn.(Var); ok {
-			varStr := string(variable)
-			if funcNames.Contains(varStr) {
-				// Calling a named function, hence var is not a usedBeforeDef variable
-			} else {
-				used[varStr] = struct{}{}
+// setUsedBeforeDef adds the variables e reads to used, except those in defs. Expressions whose children see
+// assignments of their earlier children, or which declare names, recurse themselves with the extended sets.
+func setUsedBeforeDef(e Expr, used, funcNames, defs Set) {
+	Inspect(e, func(e Expr) bool {
+		switch val := e.(type) {
+		case Program:
+			names := funcNames.Copy()
+			for _, f := range val.Funcs {
+				names.Add(f.Identifier)
+			}
+			setUsedBeforeDef(val.Code, used, names, defs)
+			return false
+		case Block:
+			blockDefs := defs.Copy()
+			for _, e := range val {
+				setUsedBeforeDef(e, used, funcNames, blockDefs)
+				blockDefs.Union(DefinedVars(e))
+			}
+			return false
+		case While:
+			// Cond may have defined some variables for Body to use
+			setUsedBeforeDef(val.Cond, used, funcNames, defs)
+			setUsedBeforeDef(val.Body, used, funcNames, defs.UnionCopy(DefinedVars(val.Cond)))
+			return false
+		case IfElse:
+			// Cond may have defined some variables for the branches to use
+			setUsedBeforeDef(val.Cond, used, funcNames, defs)
+			branchDefs := defs.UnionCopy(DefinedVars(val.Cond))
+			setUsedBeforeDef(val.Then, used, funcNames, branchDefs)
+			setUsedBeforeDef(val.Else, used, funcNames, branchDefs)
+			return false
+		case Lambda:
+			setUsedBeforeDef(val.Code, used, funcNames, defs.UnionCopy(SetFrom(val.Params...)))
+			return false
+		case Call:
+			// Because we allow arbitrary sources for a call, a called Var is read unless it names a function
+			if fnVar, ok := val.Fn.(Var); ok && funcNames.Contains(string(fnVar)) {
+				for _, arg := range val.Args {
+					setUsedBeforeDef(arg, used, funcNames, defs)
+				}
+				return false
+			}
+		case Var:
+			if !defs.Contains(string(val)) {
+				used.Add(string(val))
 			}
-		} else {
-			setUsedBeforeDef(val.Fn, used, funcNames)
 		}
-	case Index:
-		setUsedBeforeDef(val.Source, used, funcNames)
-		setUsedBeforeDef(val.I, used, funcNames)
-	case Lambda:
-		innerUsed := UsedBeforeDefVars(val.Code, funcNames)
-		// Lambda's used vars are "used \union (innerUsed \except params)
-		innerUsed.Except(SetFrom(val.Params...))
-		used.Union(innerUsed)
-	}
-	return
+		return true
+	})
 }
 
 func FreeVars(e Expr) map[string]struct{} {
@@ -190,56 +165,28 @@ func UsedVars(b Block) map[string]struct{} {
 }
 
 func setUsedVars(expr Expr, used map[string]struct{}) {
-	switch val := expr.(type) {
-	case Program:
-		setUsedVars(val.Code, used)
-	case Block:
-		for _, e := range val {
-			setUsedVars(e, used)
-		}
-	case Assn:
-		// used[string(val.V)] = struct{}{} -- the assigned Var isn't "used"
-		setUsedVars(val.E, used)
-	case Var:
-		used[string(val)] = struct{}{}
-	case Val:
-		return
-	case Arg:
-		return
-	case BinOp:
-		setUsedVars(val.Lhs, used)
-		setUsedVars(val.Rhs, used)
-	case While:
-		setUsedVars(val.Cond, used)
-		setUsedVars(val.Body, used)
-	case IfElse:
-		setUsedVars(val.Cond, used)
-		setUsedVars(val.Then, used)
-		setUsedVars(val.Else, used)
-	case Call:
-		for _, e := range val.Args {
-			setUsedVars(e, used)
-		}
-		// Because we allow arbitrary sources for a call, we need to take those into account
-		setUsedVars(val.Fn, used)
-	case Index:
-		setUsedVars(val.Source, used)
-		setUsedVars(val.I, used)
-	case Lambda:
-		innerUsed := UsedVars(val.Code)
-		// Lambda's used vars are "used \union (innerUsed \except params)
-		for _, v := range val.Params {
-			delete(innerUsed, v)
-		}
-		for v := range innerUsed {
-			used[v] = struct{}{}
-		}
+	if prog, ok := expr.(Program); ok {
+		expr = prog.Code
 	}
-	return
-}
-
-func attribToString(a Attrib) string {
-	return string(a.(*token.Token).Lit)
+	// The assigned Var of an Assn isn't "used", nor a child of it. Because we allow arbitrary sources for a call, the
+	// called Var is.
+	Inspect(expr, func(e Expr) bool {
+		switch val := e.(type) {
+		case Var:
+			used[string(val)] = struct{}{}
+		case Lambda:
+			innerUsed := UsedVars(val.Code)
+			// Lambda's used vars are "used \union (innerUsed \except params)
+			for _, v := range val.Params {
+				delete(innerUsed, v)
+			}
+			for v := range innerUsed {
+				used[v] = struct{}{}
+			}
+			return false
+		}
+		return true
+	})
 }
 
 const (
@@ -249,45 +196,23 @@ const (
 
 // checkExit returns true if we need to exit
 func checkExit(c *Context) bool {
-	if c.limitStackSize && CheckSize(c.VariableMap) > c.MaxStackSize {
-		fmt.Println("Ran out of stack space!")
-		select {
-		case c.exitChannel <- SigOutOfMemory:
-		default:
-		}
+	s := c.state()
+	if s.err != nil {
 		return true
 	}
 	select {
 	case <-c.exitChannel:
-		// c.exitChannel <- sig // No need to propagate I think?
+		// The signal is consumed, but the abort is sticky, so every frame sees it
+		c.abort(ErrExternalExit)
+		return true
+	case <-s.ctx.Done():
+		c.abort(s.ctx.Err())
 		return true
 	default:
 		return false
 	}
 }
 
-/*
-Complete switch of expressions
-
-	switch val := expr.(type) {
-	case Program:
-	case Block:
-	case Assn:
-	case Var:
-	case Val:
-	case Arg:
-	case Index:
-	case BinOp:
-	case IfElse:
-	case While:
-	case Call:
-	case Lambda:
-	}
-
-*/
-
-
-
 type Set map[string]struct{}
 
 func (s Set) Add(els ...string) {
@@ -365,15 +290,18 @@ func (s Set) Copy() Set {
 	return s2
 }
 
-func (s Set) String() string {
+// Sorted returns the elements of s in ascending order
+func (s Set) Sorted() []string {
 	els := make([]string, 0, len(s))
 	for k := range s {
 		els = append(els, k)
 	}
-
 	sort.Strings(els)
+	return els
+}
 
-	return "{ " + strings.Join(els, ", ") + " }"
+func (s Set) String() string {
+	return "{ " + strings.Join(s.Sorted(), ", ") + " }"
 }
 
 func SetFrom(els ...string) Set {
@@ -0,0 +1,38 @@
+package ast_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+func TestUsedBeforeDefVars(t *testing.T) {
+	tests := []struct {
+		src  string
+		want []string
+	}{
+		{`x = "a"; x + y`, []string{"y"}},
+		{`x = x + "a"; x`, []string{"x"}},
+		{`(x = "a") + x`, []string{"x"}},
+		{`if ((x = x + "a") == "") { x } else { y = "b"; y }`, []string{"x"}},
+		{`if (c) { x = "a" } else { "" }; x`, []string{"c"}},
+		{`while ((x = "a") == y) { x + z }`, []string{"y", "z"}},
+		{`f = fun(a) { a + b + x }; x = "c"; f`, []string{"b", "x"}},
+		{`x = "a"; fun(a) { a + x }`, []string{}},
+		{`fun f() { "" } f(g(x))`, []string{"g", "x"}},
+		{`x = ; y`, []string{"y"}},
+		{`fun f() { "" }; f + y`, []string{"f", "y"}},
+	}
+	for _, tt := range tests {
+		e, err := stringlang.ParseRecover([]byte(tt.src))
+		if e == nil {
+			t.Fatalf("parsing %q: %v", tt.src, err)
+		}
+		got := ast.UsedBeforeDefVars(e, ast.EmptySet()).Sorted()
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
@@ -4,14 +4,6 @@ import "strconv"
 
 type Val string
 
-func NewVal(a Attrib) (Expr, error) {
-	quoted := attribToString(a)
-	res, err := strconv.Unquote(quoted)
-	if err != nil {
-		panic(err)
-	}
-	return Val(res), nil
-}
 func (v Val) Eval(c *Context) Val {
 	return v
 }
@@ -0,0 +1,173 @@
+package ast
+
+// A Visitor's Visit method is called by Walk for every expression. If the visitor w it returns is not nil, Walk visits
+// each of the children of the expression with w, followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(e Expr) (w Visitor)
+}
+
+// Walk traverses the expression tree of e in depth-first order, starting with v.Visit(e), see Visitor. The children of
+// an expression are visited in the order they appear in the source code:
+//
+//	Program: the Code of each of its Funcs, then its Code
+//	Block:   its elements
+//	Assn:    E, the assigned Var is a field rather than a child
+//	BinOp:   Lhs, Rhs
+//	Call:    Fn, Args
+//	IfElse:  Cond, Then, Else
+//	Index:   Source, I
+//	Lambda:  Code
+//	While:   Cond, Body
+//
+// Var, Val, Arg and Bad have no children. FuncDecl isn't an expression, hence the visitor isn't told which function
+// the Code it visits belongs to; visitors which need to know walk the Code of each of a Program's Funcs themselves.
+func Walk(v Visitor, e Expr) {
+	if v = v.Visit(e); v == nil {
+		return
+	}
+	switch val := e.(type) {
+	case Program:
+		for _, f := range val.Funcs {
+			Walk(v, f.Code)
+		}
+		Walk(v, val.Code)
+	case Block:
+		for _, e := range val {
+			Walk(v, e)
+		}
+	case Assn:
+		Walk(v, val.E)
+	case BinOp:
+		Walk(v, val.Lhs)
+		Walk(v, val.Rhs)
+	case Call:
+		Walk(v, val.Fn)
+		for _, e := range val.Args {
+			Walk(v, e)
+		}
+	case IfElse:
+		Walk(v, val.Cond)
+		Walk(v, val.Then)
+		Walk(v, val.Else)
+	case Index:
+		Walk(v, val.Source)
+		Walk(v, val.I)
+	case Lambda:
+		Walk(v, val.Code)
+	case While:
+		Walk(v, val.Cond)
+		Walk(v, val.Body)
+	case Var, Val, Arg, Bad:
+	}
+	v.Visit(nil)
+}
+
+type inspector func(Expr) bool
+
+func (f inspector) Visit(e Expr) Visitor {
+	if f(e) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses the expression tree of e like Walk, calling f for every expression. If f returns true, Inspect
+// continues with the children of the expression, followed by a call of f(nil).
+func Inspect(e Expr, f func(Expr) bool) {
+	Walk(inspector(f), e)
+}
+
+// Cursor describes an expression passed to the function of Rewrite
+type Cursor struct {
+	Expr Expr   // The expression, whose children have already been rewritten
+	Path []Expr // The ancestors of Expr before rewriting them, starting with the root, only valid during the call
+	Name string // The field of the parent holding Expr, e.g. "Cond", or "Funcs" for the code of functions
+	// The index of Expr within the field of the parent or the Block holding it, -1 if the field holds a single
+	// expression
+	Index int
+}
+
+// Parent returns the expression directly containing c.Expr, nil for the root
+func (c *Cursor) Parent() Expr {
+	if len(c.Path) == 0 {
+		return nil
+	}
+	return c.Path[len(c.Path)-1]
+}
+
+// Rewrite returns e with every expression in it replaced by the result of f. The tree is traversed in post-order, i.e.
+// f sees expressions after their children have been rewritten, hence it can't prevent them from being rewritten.
+// Children are visited in the order documented by Walk; the elements of a Block have an empty Name. Results of f which
+// replace the Code of a Program, a function or a Lambda and aren't a Block are wrapped in one. e itself isn't modified.
+func Rewrite(e Expr, f func(c *Cursor) Expr) Expr {
+	r := &rewriter{f: f}
+	return r.rewrite(e, "", -1)
+}
+
+type rewriter struct {
+	f    func(c *Cursor) Expr
+	path []Expr
+}
+
+func (r *rewriter) rewrite(e Expr, name string, index int) Expr {
+	r.path = append(r.path, e)
+	switch val := e.(type) {
+	case Program:
+		funcs := make([]FuncDecl, len(val.Funcs))
+		for i, f := range val.Funcs {
+			f.Code = r.block(f.Code, "Funcs", i)
+			funcs[i] = f
+		}
+		val.Funcs = funcs
+		val.Code = r.block(val.Code, "Code", -1)
+		e = val
+	case Block:
+		b := make(Block, len(val))
+		for i := range val {
+			b[i] = r.rewrite(val[i], "", i)
+		}
+		e = b
+	case Assn:
+		val.E = r.rewrite(val.E, "E", -1)
+		e = val
+	case BinOp:
+		val.Lhs = r.rewrite(val.Lhs, "Lhs", -1)
+		val.Rhs = r.rewrite(val.Rhs, "Rhs", -1)
+		e = val
+	case Call:
+		val.Fn = r.rewrite(val.Fn, "Fn", -1)
+		args := make(CallArgs, len(val.Args))
+		for i := range val.Args {
+			args[i] = r.rewrite(val.Args[i], "Args", i)
+		}
+		val.Args = args
+		e = val
+	case IfElse:
+		val.Cond = r.rewrite(val.Cond, "Cond", -1)
+		val.Then = r.rewrite(val.Then, "Then", -1)
+		val.Else = r.rewrite(val.Else, "Else", -1)
+		e = val
+	case Index:
+		val.Source = r.rewrite(val.Source, "Source", -1)
+		val.I = r.rewrite(val.I, "I", -1)
+		e = val
+	case Lambda:
+		val.Code = r.block(val.Code, "Code", -1)
+		e = val
+	case While:
+		val.Cond = r.rewrite(val.Cond, "Cond", -1)
+		val.Body = r.rewrite(val.Body, "Body", -1)
+		e = val
+	case Var, Val, Arg, Bad:
+	}
+	r.path = r.path[:len(r.path)-1]
+	return r.f(&Cursor{Expr: e, Path: r.path, Name: name, Index: index})
+}
+
+func (r *rewriter) block(b Block, name string, index int) Block {
+	e := r.rewrite(b, name, index)
+	if b, ok := e.(Block); ok {
+		return b
+	}
+	return Block{e}
+}
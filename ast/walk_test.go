@@ -0,0 +1,49 @@
+package ast_test
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/skius/stringlang/ast"
+)
+
+func TestInspect(t *testing.T) {
+	e := parse(t, `fun f(a) { a } x = f(%0)[y]; if (x) { fun(b) { b + z } } else { "c" }`)
+	var vars []string
+	ast.Inspect(e, func(e ast.Expr) bool {
+		if v, ok := e.(ast.Var); ok {
+			vars = append(vars, string(v))
+		}
+		return true
+	})
+	want := []string{"a", "f", "y", "x", "b", "z"}
+	if !reflect.DeepEqual(vars, want) {
+		t.Errorf("got variables %q, want %q", vars, want)
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	e := parse(t, `x = a + "1"; f(a, fun(a) { a }); while (a) { a[0] }`)
+	got := ast.Rewrite(e, func(c *ast.Cursor) ast.Expr {
+		if c.Expr != ast.Var("a") {
+			return c.Expr
+		}
+		for _, p := range c.Path {
+			if _, ok := p.(ast.Lambda); ok {
+				return c.Expr
+			}
+		}
+		if c.Name == "Args" {
+			return ast.Val(strconv.Itoa(c.Index))
+		}
+		return ast.Var(c.Name)
+	})
+	want := parse(t, `x = Lhs + "1"; f("0", fun(a) { a }); while (Cond) { Source[0] }`)
+	if !ast.Equal(got, want) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+	if !ast.Equal(e, parse(t, `x = a + "1"; f(a, fun(a) { a }); while (a) { a[0] }`)) {
+		t.Errorf("Rewrite modified its argument: %s", e)
+	}
+}
@@ -5,20 +5,19 @@ import "strings"
 type While struct {
 	Cond Expr
 	Body Expr
+	Pos  Pos // Position of the "while" keyword
 }
 
-func NewWhile(c, b Attrib) (Expr, error) {
-	co := c.(Expr)
-	bo := b.(Expr)
-	return While{Cond: co, Body: bo}, nil
-}
 func (e While) Eval(c *Context) Val {
-	var cond Val = e.Cond.Eval(c)
+	var cond Val = c.eval(e.Cond)
 	var body Val
 	steps := 0
 	for BoolOf(cond) {
-		body = e.Body.Eval(c)
-		cond = e.Cond.Eval(c)
+		if c.Hooks != nil {
+			c.Hooks.LoopIteration(c, e, steps)
+		}
+		body = c.eval(e.Body)
+		cond = c.eval(e.Cond)
 
 		if checkExit(c) {
 			break
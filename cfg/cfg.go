@@ -108,13 +108,13 @@ func fillBlock(entryPred *Node, block ast.Block, ctr *counter, isBranch bool) []
 		case ast.IfElse:
 			condNode := buildNode(e.Cond, ctr)
 			updateSucc(condNode)
-			tExits := fillBlock(condNode, e.Then.(ast.Block), ctr, true)
-			nExits := fillBlock(condNode, e.Else.(ast.Block), ctr, false)
+			tExits := fillBlock(condNode, asBlock(e.Then), ctr, true)
+			nExits := fillBlock(condNode, asBlock(e.Else), ctr, false)
 			preds = append(tExits, nExits...)
 		case ast.While:
 			condNode := buildNode(e.Cond, ctr)
 			updateSucc(condNode)
-			bExits := fillBlock(condNode, e.Body.(ast.Block), ctr, true)
+			bExits := fillBlock(condNode, asBlock(e.Body), ctr, true)
 			for _, pred := range bExits {
 				pred.SuccNotTaken = condNode
 			}
@@ -129,6 +129,14 @@ func fillBlock(entryPred *Node, block ast.Block, ctr *counter, isBranch bool) []
 	return preds
 }
 
+// asBlock returns the statements of a branch or a loop body, an else-if is the only statement of its else branch
+func asBlock(e ast.Expr) ast.Block {
+	if b, ok := e.(ast.Block); ok {
+		return b
+	}
+	return ast.Block{e}
+}
+
 func buildNode(expr ast.Expr, ctr *counter) *Node {
 	n := new(Node)
 	n.Label = ctr.incAndGet()
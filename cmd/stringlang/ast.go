@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+// runAST prints the syntax tree of the given file, or stdin if there is none
+func runAST(args []string) error {
+	flags := flag.NewFlagSet("ast", flag.ContinueOnError)
+	asJSON := flags.Bool("json", false, "Print the tree as JSON, see ast.EncodeJSON, instead of an outline")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: stringlang ast [--json] [<program.stringlang>]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var file string
+	var src []byte
+	var err error
+	switch flags.NArg() {
+	case 0:
+		file = "<stdin>"
+		src, err = ioutil.ReadAll(os.Stdin)
+	case 1:
+		file = flags.Arg(0)
+		src, err = ioutil.ReadFile(file)
+	default:
+		flags.Usage()
+		return fmt.Errorf("more than one file given")
+	}
+	if err != nil {
+		return err
+	}
+
+	// Statements with syntax errors are printed as Bad, and the errors reported afterwards
+	e, parseErr := stringlang.ParseRecover(src)
+	if e == nil {
+		return fmt.Errorf("%s:%v", file, parseErr)
+	}
+	if *asJSON {
+		data, err := ast.EncodeJSON(e)
+		if err != nil {
+			return err
+		}
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, data, "", "  "); err != nil {
+			return err
+		}
+		indented.WriteByte('\n')
+		if _, err := indented.WriteTo(os.Stdout); err != nil {
+			return err
+		}
+	} else {
+		printOutline(os.Stdout, e)
+	}
+	if parseErr != nil {
+		return fmt.Errorf("%s:%v", file, parseErr)
+	}
+	return nil
+}
+
+// printOutline prints e as a tree of one expression per line, indented by their depth and followed by their position
+func printOutline(w io.Writer, e ast.Expr) {
+	if prog, ok := e.(ast.Program); ok {
+		fmt.Fprintln(w, "Program")
+		for _, f := range prog.Funcs {
+			fmt.Fprintf(w, "  Func %s(%s) %v\n", f.Identifier, strings.Join(f.Params, ", "), f.Pos)
+			ast.Walk(outliner{w: w, depth: 2}, f.Code)
+		}
+		e = prog.Code
+	}
+	ast.Walk(outliner{w: w, depth: 1}, e)
+}
+
+// outliner prints the outline of expressions at depth
+type outliner struct {
+	w     io.Writer
+	depth int
+}
+
+func (o outliner) Visit(e ast.Expr) ast.Visitor {
+	if e == nil {
+		return nil
+	}
+	var line string
+	switch val := e.(type) {
+	case ast.Assn:
+		line = "Assn " + string(val.V)
+	case ast.BinOp:
+		line = "BinOp " + val.Op.String()
+	case ast.Lambda:
+		line = "Lambda(" + strings.Join(val.Params, ", ") + ")"
+	case ast.Var:
+		line = "Var " + string(val)
+	case ast.Val:
+		line = "Val " + strconv.Quote(string(val))
+	case ast.Arg:
+		line = "Arg %" + strconv.Itoa(int(val))
+	default:
+		line = strings.TrimPrefix(fmt.Sprintf("%T", e), "ast.")
+	}
+	if pos := ast.PosOf(e); pos.IsValid() {
+		line += " " + pos.String()
+	}
+	fmt.Fprintln(o.w, strings.Repeat("  ", o.depth)+line)
+	return outliner{w: o.w, depth: o.depth + 1}
+}
@@ -0,0 +1,407 @@
+// Package dap implements a Debug Adapter Protocol server for StringLang programs, see
+// https://microsoft.github.io/debug-adapter-protocol/
+package dap
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/cmd/stringlang/internal/wire"
+	"github.com/skius/stringlang/debugger"
+)
+
+// threadID is the ID of the only thread, StringLang programs are evaluated on a single goroutine
+const threadID = 1
+
+var errNotPaused = errors.New("the program is not paused")
+
+// Server debugs a single StringLang program for a client speaking the Debug Adapter Protocol
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	writeMu sync.Mutex // Guards out and seq
+	seq     int
+
+	dbg    *debugger.Debugger
+	launch launchArguments
+	prog   ast.Program
+	resume chan debugger.Action // Continues the paused program
+
+	mu      sync.Mutex // Guards the fields below, which the evaluating goroutine modifies
+	stop    *debugger.Stop
+	cancel  context.CancelFunc
+	running bool
+	entry   bool // Whether the next stop is the one requested by stopOnEntry
+}
+
+// handler handles the arguments of a request and returns the body of the response. The function it may return as
+// well is called after the response was sent.
+type handler func(s *Server, args json.RawMessage) (body interface{}, after func(), err error)
+
+var handlers map[string]handler
+
+func init() {
+	handlers = map[string]handler{
+		"initialize":              (*Server).initialize,
+		"launch":                  (*Server).launchProgram,
+		"setBreakpoints":          (*Server).setBreakpoints,
+		"setFunctionBreakpoints":  (*Server).setFunctionBreakpoints,
+		"setExceptionBreakpoints": (*Server).setExceptionBreakpoints,
+		"configurationDone":       (*Server).configurationDone,
+		"threads":                 (*Server).threads,
+		"stackTrace":              (*Server).stackTrace,
+		"scopes":                  (*Server).scopes,
+		"variables":               (*Server).variables,
+		"setVariable":             (*Server).setVariable,
+		"continue":                resumeHandler(debugger.Continue),
+		"next":                    resumeHandler(debugger.StepOver),
+		"stepIn":                  resumeHandler(debugger.StepIn),
+		"stepOut":                 resumeHandler(debugger.StepOut),
+		"pause":                   (*Server).pause,
+		"terminate":               (*Server).terminate,
+		"disconnect":              (*Server).terminate,
+	}
+}
+
+// NewServer returns a Server reading requests from in and writing responses and events to out
+func NewServer(in io.Reader, out io.Writer) *Server {
+	s := &Server{
+		in:     bufio.NewReader(in),
+		out:    out,
+		resume: make(chan debugger.Action),
+	}
+	s.dbg = debugger.New(s.onStop)
+	return s
+}
+
+// Serve handles requests until the client disconnects
+func (s *Server) Serve() error {
+	for {
+		msg, err := readMessage(s.in)
+		if err == io.EOF {
+			s.terminate(nil)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Type != "request" {
+			continue
+		}
+
+		h, ok := handlers[msg.Command]
+		if !ok {
+			s.respond(msg, nil, errors.New("unsupported request "+msg.Command))
+			continue
+		}
+		body, after, err := h(s, msg.Arguments)
+		if err := s.respond(msg, body, err); err != nil {
+			return err
+		}
+		if after != nil {
+			after()
+		}
+		if msg.Command == "disconnect" {
+			return nil
+		}
+	}
+}
+
+func (s *Server) respond(req *message, body interface{}, err error) error {
+	res := response{Type: "response", RequestSeq: req.Seq, Command: req.Command, Success: err == nil, Body: body}
+	if err != nil {
+		res.Message = err.Error()
+		res.Body = nil
+	}
+	return s.send(&res.Seq, &res)
+}
+
+func (s *Server) sendEvent(name string, body interface{}) {
+	ev := event{Type: "event", Event: name, Body: body}
+	s.send(&ev.Seq, &ev)
+}
+
+// send numbers and writes msg, whose sequence number is at seq
+func (s *Server) send(seq *int, msg interface{}) error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.seq++
+	*seq = s.seq
+	return wire.WriteMessage(s.out, msg)
+}
+
+func (s *Server) initialize(_ json.RawMessage) (interface{}, func(), error) {
+	return map[string]bool{
+		"supportsConfigurationDoneRequest": true,
+		"supportsFunctionBreakpoints":      true,
+		"supportsSetVariable":              true,
+		"supportsTerminateRequest":         true,
+	}, nil, nil
+}
+
+func (s *Server) launchProgram(raw json.RawMessage) (interface{}, func(), error) {
+	if err := json.Unmarshal(raw, &s.launch); err != nil {
+		return nil, nil, err
+	}
+	src, err := ioutil.ReadFile(s.launch.Program)
+	if err != nil {
+		return nil, nil, err
+	}
+	expr, err := stringlang.Parse(src)
+	if err != nil {
+		return nil, nil, errors.New(s.launch.Program + ":" + err.Error())
+	}
+	s.prog = expr.(ast.Program)
+	// Breakpoints can only be verified once the program is known, hence only now ask for them
+	return nil, func() { s.sendEvent("initialized", nil) }, nil
+}
+
+func (s *Server) setBreakpoints(raw json.RawMessage) (interface{}, func(), error) {
+	var args setBreakpointsArguments
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return nil, nil, err
+	}
+	lines := debugger.Lines(s.prog)
+	breakpoints := make([]breakpoint, len(args.Breakpoints))
+	set := make([]int, 0, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		// Breakpoints on lines without statements move to the next statement
+		idx := sort.SearchInts(lines, bp.Line)
+		if idx == len(lines) {
+			breakpoints[i] = breakpoint{Verified: false, Line: bp.Line, Message: "No statement at or after this line"}
+			continue
+		}
+		breakpoints[i] = breakpoint{Verified: true, Line: lines[idx]}
+		set = append(set, lines[idx])
+	}
+	s.dbg.SetLineBreakpoints(set)
+	return map[string]interface{}{"breakpoints": breakpoints}, nil, nil
+}
+
+func (s *Server) setFunctionBreakpoints(raw json.RawMessage) (interface{}, func(), error) {
+	var args setFunctionBreakpointsArguments
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, len(args.Breakpoints))
+	breakpoints := make([]breakpoint, len(args.Breakpoints))
+	for i, bp := range args.Breakpoints {
+		names[i] = bp.Name
+		breakpoints[i] = breakpoint{Verified: true}
+	}
+	s.dbg.SetFuncBreakpoints(names)
+	return map[string]interface{}{"breakpoints": breakpoints}, nil, nil
+}
+
+func (s *Server) setExceptionBreakpoints(_ json.RawMessage) (interface{}, func(), error) {
+	// There are no exceptions to break on, errors simply end the program
+	return nil, nil, nil
+}
+
+func (s *Server) configurationDone(_ json.RawMessage) (interface{}, func(), error) {
+	if s.prog.Code == nil {
+		return nil, nil, errors.New("no program was launched")
+	}
+	return nil, s.run, nil
+}
+
+// run starts evaluating the launched program on a new goroutine
+func (s *Server) run() {
+	ctx := stringlang.ExampleContext(true)
+	ctx.Args = s.launch.Args
+	if !s.launch.NoDebug {
+		ctx.SetHooks(s.dbg)
+		if s.launch.StopOnEntry {
+			s.dbg.Step(debugger.StepIn)
+		}
+	}
+	goCtx, cancel := context.WithCancel(context.Background())
+
+	s.mu.Lock()
+	s.cancel = cancel
+	s.running = true
+	s.entry = s.launch.StopOnEntry
+	s.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		result, err := stringlang.EvalContext(goCtx, ctx, s.prog)
+		exitCode := 0
+		if err != nil {
+			exitCode = 1
+			s.sendEvent("output", map[string]string{"category": "stderr", "output": err.Error() + "\n"})
+		} else {
+			s.sendEvent("output", map[string]string{"category": "stdout", "output": result + "\n"})
+		}
+
+		s.mu.Lock()
+		s.running = false
+		s.mu.Unlock()
+		s.sendEvent("exited", map[string]int{"exitCode": exitCode})
+		s.sendEvent("terminated", nil)
+	}()
+}
+
+// onStop is called on the evaluating goroutine, it waits until the client continues the program
+func (s *Server) onStop(stop *debugger.Stop) debugger.Action {
+	s.mu.Lock()
+	s.stop = stop
+	reason := stop.Reason.String()
+	if s.entry {
+		reason = "entry"
+		s.entry = false
+	}
+	s.mu.Unlock()
+
+	s.sendEvent("stopped", map[string]interface{}{
+		"reason":            reason,
+		"threadId":          threadID,
+		"allThreadsStopped": true,
+	})
+	return <-s.resume
+}
+
+// paused returns where the program is paused, or nil if it isn't
+func (s *Server) paused() *debugger.Stop {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stop
+}
+
+// resumeHandler returns the handler of the request continuing the paused program according to a
+func resumeHandler(a debugger.Action) handler {
+	return func(s *Server, _ json.RawMessage) (interface{}, func(), error) {
+		if s.paused() == nil {
+			return nil, nil, errNotPaused
+		}
+		var body interface{}
+		if a == debugger.Continue {
+			body = map[string]bool{"allThreadsContinued": true}
+		}
+		return body, func() { s.continueWith(a) }, nil
+	}
+}
+
+func (s *Server) continueWith(a debugger.Action) {
+	s.mu.Lock()
+	s.stop = nil
+	s.mu.Unlock()
+	s.resume <- a
+}
+
+func (s *Server) pause(_ json.RawMessage) (interface{}, func(), error) {
+	s.dbg.Pause()
+	return nil, nil, nil
+}
+
+func (s *Server) terminate(_ json.RawMessage) (interface{}, func(), error) {
+	s.mu.Lock()
+	cancel, running := s.cancel, s.running
+	s.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+	if running && s.paused() != nil {
+		// The cancelled program stops at its next step
+		s.dbg.ClearAll()
+		s.continueWith(debugger.Continue)
+	}
+	return nil, nil, nil
+}
+
+func (s *Server) threads(_ json.RawMessage) (interface{}, func(), error) {
+	return map[string][]thread{"threads": {{ID: threadID, Name: "main"}}}, nil, nil
+}
+
+func (s *Server) stackTrace(_ json.RawMessage) (interface{}, func(), error) {
+	stop := s.paused()
+	if stop == nil {
+		return nil, nil, errNotPaused
+	}
+	src := &source{Name: filepath.Base(s.launch.Program), Path: s.launch.Program}
+	frames := make([]stackFrame, len(stop.Frames))
+	for i, f := range stop.Frames {
+		// Frame IDs start at 1, they double as the reference to the variables of the frame
+		frames[i] = stackFrame{ID: i + 1, Name: f.Name, Source: src, Line: f.Pos.Line, Column: f.Pos.Column}
+	}
+	return map[string]interface{}{"stackFrames": frames, "totalFrames": len(frames)}, nil, nil
+}
+
+// frame returns the frame with the given ID, see stackTrace
+func (s *Server) frame(id int) (*debugger.Frame, error) {
+	stop := s.paused()
+	if stop == nil {
+		return nil, errNotPaused
+	}
+	if id < 1 || id > len(stop.Frames) {
+		return nil, errors.New("unknown frame " + strconv.Itoa(id))
+	}
+	return stop.Frames[id-1], nil
+}
+
+func (s *Server) scopes(raw json.RawMessage) (interface{}, func(), error) {
+	var args scopesArguments
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return nil, nil, err
+	}
+	if _, err := s.frame(args.FrameID); err != nil {
+		return nil, nil, err
+	}
+	scopes := []scope{{Name: "Locals", VariablesReference: args.FrameID}}
+	return map[string][]scope{"scopes": scopes}, nil, nil
+}
+
+func (s *Server) variables(raw json.RawMessage) (interface{}, func(), error) {
+	var args variablesArguments
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return nil, nil, err
+	}
+	f, err := s.frame(args.VariablesReference)
+	if err != nil {
+		return nil, nil, err
+	}
+	vars := f.Context.VariableMap
+	names := make([]string, 0, len(vars))
+	for v := range vars {
+		names = append(names, string(v))
+	}
+	sort.Strings(names)
+
+	variables := make([]variable, len(names))
+	for i, name := range names {
+		variables[i] = variable{Name: name, Value: strconv.Quote(string(vars[ast.Var(name)])), Type: "string"}
+	}
+	return map[string][]variable{"variables": variables}, nil, nil
+}
+
+func (s *Server) setVariable(raw json.RawMessage) (interface{}, func(), error) {
+	var args setVariableArguments
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return nil, nil, err
+	}
+	f, err := s.frame(args.VariablesReference)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Values are shown quoted, so they are most likely entered quoted as well
+	value := args.Value
+	if strings.HasPrefix(value, `"`) {
+		if value, err = strconv.Unquote(value); err != nil {
+			return nil, nil, errors.New("invalid string literal " + args.Value)
+		}
+	}
+	f.Context.Set(args.Name, value)
+	return map[string]string{"value": strconv.Quote(value), "type": "string"}, nil, nil
+}
@@ -0,0 +1,211 @@
+package dap
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skius/stringlang/cmd/stringlang/internal/wire"
+)
+
+const testProgram = `fun greet(name) {
+	greeting = "Hello, " + name;
+	greeting + "!"
+}
+who = %0;
+res = greet(who);
+res + " " + %1
+`
+
+// client is a scripted Debug Adapter Protocol client
+type client struct {
+	t    *testing.T
+	in   io.Writer
+	out  *bufio.Reader
+	seq  int
+	msgs chan map[string]interface{}
+}
+
+func newClient(t *testing.T) *client {
+	reqR, reqW := io.Pipe()
+	resR, resW := io.Pipe()
+	s := NewServer(reqR, resW)
+	go func() {
+		if err := s.Serve(); err != nil {
+			t.Error(err)
+		}
+		resW.Close()
+	}()
+
+	c := &client{t: t, in: reqW, out: bufio.NewReader(resR), msgs: make(chan map[string]interface{}, 100)}
+	go func() {
+		defer close(c.msgs)
+		for {
+			raw, err := wire.ReadMessage(c.out)
+			if err != nil {
+				return
+			}
+			var msg map[string]interface{}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				t.Error(err)
+				return
+			}
+			c.msgs <- msg
+		}
+	}()
+	return c
+}
+
+// request sends a request and returns the body of its response, failing the test if it wasn't successful
+func (c *client) request(command string, args interface{}) map[string]interface{} {
+	c.seq++
+	req := map[string]interface{}{"seq": c.seq, "type": "request", "command": command, "arguments": args}
+	if err := wire.WriteMessage(c.in, req); err != nil {
+		c.t.Fatal(err)
+	}
+	res := c.expect("response", command)
+	if res["success"] != true {
+		c.t.Fatalf("%s failed: %v", command, res["message"])
+	}
+	body, _ := res["body"].(map[string]interface{})
+	return body
+}
+
+// expect returns the next message of the given type and command or event, skipping all other messages
+func (c *client) expect(typ, name string) map[string]interface{} {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case msg, ok := <-c.msgs:
+			if !ok {
+				c.t.Fatalf("server closed the connection while waiting for %s %s", typ, name)
+			}
+			if msg["type"] == typ && (msg["command"] == name || msg["event"] == name) {
+				return msg
+			}
+		case <-timeout:
+			c.t.Fatalf("timed out waiting for %s %s", typ, name)
+		}
+	}
+}
+
+func (c *client) expectStop(reason string, line int) {
+	ev := c.expect("event", "stopped")
+	body := ev["body"].(map[string]interface{})
+	if body["reason"] != reason {
+		c.t.Fatalf("expected to stop because of %s, got %v", reason, body["reason"])
+	}
+	frames := c.stackTrace()
+	if got := int(frames[0]["line"].(float64)); got != line {
+		c.t.Fatalf("expected to stop at line %d, got %d", line, got)
+	}
+}
+
+func (c *client) stackTrace() []map[string]interface{} {
+	body := c.request("stackTrace", map[string]int{"threadId": threadID})
+	var frames []map[string]interface{}
+	for _, f := range body["stackFrames"].([]interface{}) {
+		frames = append(frames, f.(map[string]interface{}))
+	}
+	return frames
+}
+
+func (c *client) variables(frameID int) map[string]string {
+	scopes := c.request("scopes", map[string]int{"frameId": frameID})["scopes"].([]interface{})
+	ref := scopes[0].(map[string]interface{})["variablesReference"]
+	body := c.request("variables", map[string]interface{}{"variablesReference": ref})
+	vars := make(map[string]string)
+	for _, v := range body["variables"].([]interface{}) {
+		variable := v.(map[string]interface{})
+		vars[variable["name"].(string)] = variable["value"].(string)
+	}
+	return vars
+}
+
+func TestDebugSession(t *testing.T) {
+	program := filepath.Join(t.TempDir(), "greet.stringlang")
+	if err := ioutil.WriteFile(program, []byte(testProgram), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newClient(t)
+	caps := c.request("initialize", map[string]string{"adapterID": "stringlang"})
+	if caps["supportsConfigurationDoneRequest"] != true {
+		t.Error("configurationDone should be supported")
+	}
+	c.request("launch", map[string]interface{}{"program": program, "args": []string{"World", "bye"}})
+	c.expect("event", "initialized")
+
+	body := c.request("setBreakpoints", map[string]interface{}{
+		"source":      map[string]string{"path": program},
+		"breakpoints": []map[string]int{{"line": 5}, {"line": 4}, {"line": 42}},
+	})
+	bps := body["breakpoints"].([]interface{})
+	if bp := bps[1].(map[string]interface{}); bp["verified"] != true || bp["line"] != 5.0 {
+		t.Errorf("breakpoint on line without statement should move to line 5, got %v", bp)
+	}
+	if bp := bps[2].(map[string]interface{}); bp["verified"] != false {
+		t.Errorf("breakpoint after the last statement should not be verified, got %v", bp)
+	}
+	c.request("setFunctionBreakpoints", map[string]interface{}{
+		"breakpoints": []map[string]string{{"name": "greet"}},
+	})
+	c.request("configurationDone", nil)
+
+	c.expectStop("breakpoint", 5)
+	c.request("next", map[string]int{"threadId": threadID})
+	c.expectStop("step", 6)
+	c.request("next", map[string]int{"threadId": threadID})
+	c.expectStop("function breakpoint", 2)
+
+	frames := c.stackTrace()
+	if len(frames) != 2 || frames[0]["name"] != "greet" || frames[1]["name"] != "<top level>" {
+		t.Fatalf("unexpected stack frames %v", frames)
+	}
+	if vars := c.variables(1); vars["name"] != `"World"` {
+		t.Errorf("expected name to be \"World\", got %v", vars)
+	}
+	if vars := c.variables(2); vars["who"] != `"World"` {
+		t.Errorf("expected who to be \"World\" in the top level, got %v", vars)
+	}
+
+	c.request("next", map[string]int{"threadId": threadID})
+	c.expectStop("step", 3)
+	c.request("setVariable", map[string]interface{}{"variablesReference": 1, "name": "greeting", "value": `"Hi"`})
+
+	c.request("stepOut", map[string]int{"threadId": threadID})
+	c.expectStop("step", 7)
+	c.request("continue", map[string]int{"threadId": threadID})
+
+	output := c.expect("event", "output")["body"].(map[string]interface{})
+	if output["output"] != "Hi! bye\n" {
+		t.Errorf("expected the modified result, got %q", output["output"])
+	}
+	c.expect("event", "terminated")
+	c.request("disconnect", nil)
+}
+
+func TestStopOnEntryAndTerminate(t *testing.T) {
+	program := filepath.Join(t.TempDir(), "loop.stringlang")
+	if err := ioutil.WriteFile(program, []byte("x = \"\";\nwhile (x != \"never\") { x = \"a\" }"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	c := newClient(t)
+	c.request("initialize", nil)
+	c.request("launch", map[string]interface{}{"program": program, "stopOnEntry": true})
+	c.request("configurationDone", nil)
+	c.expectStop("entry", 1)
+
+	c.request("continue", map[string]int{"threadId": threadID})
+	c.request("pause", map[string]int{"threadId": threadID})
+	c.expectStop("pause", 2)
+
+	c.request("terminate", nil)
+	c.expect("event", "terminated")
+	c.request("disconnect", nil)
+}
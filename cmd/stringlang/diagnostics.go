@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/skius/stringlang/diag"
+)
+
+// Formats of diagnostics, see the --format flags
+const (
+	formatText  = "text"
+	formatJSON  = "json"
+	formatSARIF = "sarif"
+)
+
+const formatUsage = "Print diagnostics as text, json or sarif [SARIF 2.1.0]"
+
+// tool describes the CLI in SARIF logs
+var tool = diag.Tool{Name: "stringlang", InformationURI: "https://github.com/skius/stringlang"}
+
+func checkFormat(format string) error {
+	switch format {
+	case formatText, formatJSON, formatSARIF:
+		return nil
+	}
+	return fmt.Errorf("unknown format %q, expected text, json or sarif", format)
+}
+
+// writeDiagnostics writes ds to w in format, which checkFormat accepts. Text shows the source code of the spans, sources
+// maps files to their source code.
+func writeDiagnostics(w io.Writer, format string, tool diag.Tool, ds []diag.Diagnostic, sources map[string][]byte) error {
+	switch format {
+	case formatJSON:
+		return diag.WriteJSON(w, ds)
+	case formatSARIF:
+		return diag.WriteSARIF(w, tool, ds)
+	}
+	for _, d := range ds {
+		if _, err := fmt.Fprint(w, d.Render(sources[d.Span.File])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/skius/stringlang/format"
+)
+
+// runFmt formats the given files, or stdin if there are none, like gofmt
+func runFmt(args []string) error {
+	flags := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	write := flags.Bool("w", false, "Write the result to the source files instead of stdout")
+	diff := flags.Bool("d", false, "Print diffs instead of the formatted source")
+	indent := flags.Int("indent", len(format.DefaultIndent), "Indent using argument spaces")
+	tabs := flags.Bool("tabs", false, "Indent using tabs [overrides --indent]")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: stringlang fmt [-w] [-d] [--indent=n | --tabs] [<program.stringlang> ...]")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	opts := format.Options{Indent: strings.Repeat(" ", *indent)}
+	if *tabs {
+		opts.Indent = "\t"
+	}
+	if *indent <= 0 && !*tabs {
+		return errors.New("indentation must be at least one space")
+	}
+
+	if flags.NArg() == 0 {
+		if *write {
+			return errors.New("can't write the result when formatting stdin")
+		}
+		src, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		return formatFile("<stdin>", src, opts, false, *diff)
+	}
+
+	failed := false
+	for _, file := range flags.Args() {
+		src, err := ioutil.ReadFile(file)
+		if err == nil {
+			err = formatFile(file, src, opts, *write, *diff)
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			failed = true
+		}
+	}
+	if failed {
+		return errors.New("some files could not be formatted")
+	}
+	return nil
+}
+
+// formatFile formats src, the source code of file. Statements with syntax errors are kept as written, the rest is
+// formatted regardless, and the errors are reported after writing the result.
+func formatFile(file string, src []byte, opts format.Options, write, diff bool) error {
+	res, err := format.Source(src, opts)
+	if res == nil {
+		return fmt.Errorf("%s:%v", file, err)
+	}
+	if werr := writeFormatted(file, src, res, write, diff); werr != nil {
+		return werr
+	}
+	if err != nil {
+		return fmt.Errorf("%s:%v", file, err)
+	}
+	return nil
+}
+
+// writeFormatted writes res, the formatted src of file, as formatFile is told to
+func writeFormatted(file string, src, res []byte, write, diff bool) error {
+	if diff {
+		if !bytes.Equal(src, res) {
+			fmt.Print(unifiedDiff(file, string(src), string(res)))
+		}
+	}
+	if write {
+		if bytes.Equal(src, res) {
+			return nil
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(file, res, info.Mode().Perm())
+	}
+	if !diff {
+		_, err := os.Stdout.Write(res)
+		return err
+	}
+	return nil
+}
+
+// diffContext is the number of unchanged lines shown around changes
+const diffContext = 3
+
+// unifiedDiff returns the changes from a to b in the unified format, computed using their longest common subsequence
+// of lines
+func unifiedDiff(file, a, b string) string {
+	as, bs := splitLines(a), splitLines(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of as[i:] and bs[j:]
+	lcs := make([][]int, len(as)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(bs)+1)
+	}
+	for i := len(as) - 1; i >= 0; i-- {
+		for j := len(bs) - 1; j >= 0; j-- {
+			switch {
+			case as[i] == bs[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	// Edit script, every line is prefixed by ' ', '-' or '+'
+	var lines []string
+	i, j := 0, 0
+	for i < len(as) || j < len(bs) {
+		switch {
+		case i < len(as) && j < len(bs) && as[i] == bs[j]:
+			lines = append(lines, " "+as[i])
+			i++
+			j++
+		case j == len(bs) || i < len(as) && lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, "-"+as[i])
+			i++
+		default:
+			lines = append(lines, "+"+bs[j])
+			j++
+		}
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s.orig\n+++ %s\n", file, file)
+	aLine, bLine := 1, 1 // Line numbers at lines[k]
+	hunkEnd := 0
+	for k := 0; k < len(lines); {
+		if lines[k][0] == ' ' {
+			k, aLine, bLine = k+1, aLine+1, bLine+1
+			continue
+		}
+		// A hunk starts diffContext lines before the change and ends once diffContext*2 unchanged lines follow a change
+		start := k - diffContext
+		if start < hunkEnd {
+			start = hunkEnd
+		}
+		end, unchanged := k, 0
+		for end < len(lines) && unchanged < 2*diffContext {
+			if lines[end][0] == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+			end++
+		}
+		if unchanged > diffContext {
+			end -= unchanged - diffContext
+		}
+
+		aStart, bStart := aLine-(k-start), bLine-(k-start)
+		aCount, bCount := 0, 0
+		for _, l := range lines[start:end] {
+			if l[0] != '+' {
+				aCount++
+			}
+			if l[0] != '-' {
+				bCount++
+			}
+		}
+		fmt.Fprintf(&out, "@@ -%s +%s @@\n", hunkRange(aStart, aCount), hunkRange(bStart, bCount))
+		for _, l := range lines[start:end] {
+			out.WriteString(l + "\n")
+		}
+		k, aLine, bLine, hunkEnd = end, aStart+aCount, bStart+bCount, end
+	}
+	return out.String()
+}
+
+// hunkRange returns the range of count lines starting at line start, which is the line before them if there are none
+func hunkRange(start, count int) string {
+	if count == 0 {
+		start--
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
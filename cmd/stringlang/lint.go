@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/diag"
+	"github.com/skius/stringlang/lint"
+)
+
+// runLint checks the given files for likely mistakes, failing if it finds any
+func runLint(args []string) error {
+	flags := flag.NewFlagSet("lint", flag.ContinueOnError)
+	enable := flags.String("enable", "", "Only run the comma-separated rules of argument")
+	disable := flags.String("disable", "", "Don't run the comma-separated rules of argument")
+	numArgs := flags.Int("args", 0, "Expect programs to take argument arguments, unless they declare it using lint:args")
+	listRules := flags.Bool("rules", false, "List all rules")
+	format := flags.String("format", formatText, formatUsage)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: stringlang lint [--enable=rules] [--disable=rules] [--args=n] [--format=f] <program.stringlang> ...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := checkFormat(*format); err != nil {
+		return err
+	}
+
+	if *listRules {
+		for _, r := range lint.Rules() {
+			fmt.Printf("%-20s %s\n", r.Name, r.Doc)
+		}
+		return nil
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return fmt.Errorf("no files given")
+	}
+
+	cfg := lint.Config{
+		Enable:   splitList(*enable),
+		Disable:  splitList(*disable),
+		Builtins: stringlang.ExampleContext(false).FunctionMap,
+		Args:     *numArgs,
+	}
+	var diags []diag.Diagnostic
+	sources := make(map[string][]byte)
+	for _, file := range flags.Args() {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		sources[file] = src
+		found, err := lint.Lint(src, cfg)
+		if errors.Is(err, lint.ErrUnknownRule) {
+			return err
+		}
+		if err != nil {
+			diags = append(diags, diag.FromErrors(file, src, err)...)
+			continue
+		}
+		for _, d := range found {
+			diags = append(diags, d.Diag(src).InFile(file))
+		}
+	}
+
+	lintTool := tool
+	lintTool.Rules = make(map[string]string)
+	for _, r := range lint.Rules() {
+		lintTool.Rules[r.Name] = r.Doc
+	}
+	if err := writeDiagnostics(os.Stdout, *format, lintTool, diags, sources); err != nil {
+		return err
+	}
+	switch len(diags) {
+	case 0:
+		return nil
+	case 1:
+		return errors.New("found 1 problem")
+	}
+	return fmt.Errorf("found %d problems", len(diags))
+}
+
+// splitList splits a comma-separated list, the empty string is the empty list
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
@@ -0,0 +1,279 @@
+package lsp
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/diag"
+	"github.com/skius/stringlang/internal/syntax"
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+var (
+	tokFun    = token.TokMap.Type("fun")
+	tokID     = token.TokMap.Type("id")
+	tokLParen = token.TokMap.Type("(")
+	tokRParen = token.TokMap.Type(")")
+	tokLBrace = token.TokMap.Type("{")
+	tokRBrace = token.TokMap.Type("}")
+	tokAssign = token.TokMap.Type("=")
+)
+
+// document is an open text document. Editing makes documents fail to parse most of the time, hence only diagnostics
+// and the last program that parsed come from the parser, while positions come from the tokens of the current text.
+type document struct {
+	text   []byte
+	lines  []int          // Offsets of the starts of the lines
+	tokens []*token.Token // Without the EOF token
+	prog   ast.Program    // The last version of the text that parsed
+	err    error          // Why the current text doesn't parse, if it doesn't
+	scopes []*scope       // The top level first, then functions and lambdas in order of their "fun"
+}
+
+// scope is the top level or the body of a function or lambda, which have their own variables
+type scope struct {
+	fun        *token.Token   // nil for the top level
+	name       *token.Token   // Name of the function, nil for lambdas and the top level
+	params     []*token.Token // Parameters of the function or lambda
+	start, end int            // Offsets of the "fun" (or the start of the text) and behind its "}"
+	parent     *scope
+}
+
+func (sc *scope) isLambda() bool {
+	return sc.fun != nil && sc.name == nil
+}
+
+// signature returns the declaration of the function or lambda of sc, e.g. fun f(a, b)
+func (sc *scope) signature() string {
+	params := make([]string, len(sc.params))
+	for i, p := range sc.params {
+		params[i] = p.IDValue()
+	}
+	name := ""
+	if sc.name != nil {
+		name = " " + sc.name.IDValue()
+	}
+	return "fun" + name + "(" + strings.Join(params, ", ") + ")"
+}
+
+// update replaces the text of d. If text doesn't parse, the program is the partial one, or the last one if text
+// couldn't be parsed at all.
+func (d *document) update(text []byte) {
+	d.text = text
+	d.lines = []int{0}
+	for i, b := range text {
+		if b == '\n' {
+			d.lines = append(d.lines, i+1)
+		}
+	}
+
+	d.tokens = d.tokens[:0]
+	l := syntax.NewScanner(text)
+	for tok := l.Scan(); tok.Type != token.EOF; tok = l.Scan() {
+		d.tokens = append(d.tokens, tok)
+	}
+	d.findScopes()
+
+	expr, err := stringlang.ParseRecover(text)
+	d.err = err
+	if expr != nil {
+		d.prog = expr.(ast.Program)
+	}
+}
+
+// findScopes finds the functions and lambdas of the tokens, which need not parse
+func (d *document) findScopes() {
+	top := &scope{start: 0, end: len(d.text)}
+	d.scopes = []*scope{top}
+
+	type open struct {
+		sc    *scope
+		depth int
+	}
+	stack := []open{{top, 0}}
+	bodies := make(map[int]*scope) // Index of the "{" of a body to its scope
+	depth := 0
+	for i, tok := range d.tokens {
+		switch tok.Type {
+		case tokFun:
+			sc := &scope{fun: tok, start: tok.Pos.Offset, end: len(d.text), parent: stack[len(stack)-1].sc}
+			j := i + 1
+			if d.is(j, tokID) {
+				sc.name = d.tokens[j]
+				j++
+			}
+			if !d.is(j, tokLParen) {
+				continue
+			}
+			for j++; j < len(d.tokens) && d.tokens[j].Type != tokRParen && d.tokens[j].Type != tokLBrace; j++ {
+				if d.tokens[j].Type == tokID {
+					sc.params = append(sc.params, d.tokens[j])
+				}
+			}
+			if d.is(j, tokRParen) && d.is(j+1, tokLBrace) {
+				bodies[j+1] = sc
+				d.scopes = append(d.scopes, sc)
+			}
+		case tokLBrace:
+			depth++
+			if sc, ok := bodies[i]; ok {
+				stack = append(stack, open{sc, depth})
+			}
+		case tokRBrace:
+			if top := stack[len(stack)-1]; len(stack) > 1 && top.depth == depth {
+				top.sc.end = tok.Pos.Offset + len(tok.Lit)
+				stack = stack[:len(stack)-1]
+			}
+			if depth > 0 {
+				depth--
+			}
+		}
+	}
+}
+
+// is returns whether the token at index i exists and has type typ
+func (d *document) is(i int, typ token.Type) bool {
+	return i >= 0 && i < len(d.tokens) && d.tokens[i].Type == typ
+}
+
+// scopeAt returns the innermost scope containing offset
+func (d *document) scopeAt(offset int) *scope {
+	res := d.scopes[0]
+	for _, sc := range d.scopes[1:] {
+		if sc.start <= offset && offset < sc.end && sc.start >= res.start {
+			res = sc
+		}
+	}
+	return res
+}
+
+// tokenAt returns the index of the identifier at or directly before offset, or -1 if there is none
+func (d *document) tokenAt(offset int) int {
+	i := sort.Search(len(d.tokens), func(i int) bool {
+		return d.tokens[i].Pos.Offset+len(d.tokens[i].Lit) >= offset
+	})
+	if i < len(d.tokens) && d.tokens[i].Type == tokID && d.tokens[i].Pos.Offset <= offset {
+		return i
+	}
+	return -1
+}
+
+// funcs returns the scopes of the user-defined functions by name
+func (d *document) funcs() map[string]*scope {
+	funcs := make(map[string]*scope)
+	for _, sc := range d.scopes {
+		if sc.name != nil {
+			if _, ok := funcs[sc.name.IDValue()]; !ok {
+				funcs[sc.name.IDValue()] = sc
+			}
+		}
+	}
+	return funcs
+}
+
+// assignments returns the first assignment to each variable directly in sc, i.e. not in nested functions or lambdas
+func (d *document) assignments(sc *scope) map[string]*token.Token {
+	assigned := make(map[string]*token.Token)
+	for i, tok := range d.tokens {
+		if tok.Type != tokID || !d.is(i+1, tokAssign) || d.scopeAt(tok.Pos.Offset) != sc {
+			continue
+		}
+		if _, ok := assigned[tok.IDValue()]; !ok {
+			assigned[tok.IDValue()] = tok
+		}
+	}
+	return assigned
+}
+
+// definition returns where the variable name used in sc is defined, i.e. its parameter or first assignment. Lambdas
+// capture the variables of their enclosing scope, functions don't see any but their own.
+func (d *document) definition(sc *scope, name string) (*token.Token, *scope) {
+	for ; sc != nil; sc = sc.parent {
+		for _, p := range sc.params {
+			if p.IDValue() == name {
+				return p, sc
+			}
+		}
+		if tok, ok := d.assignments(sc)[name]; ok {
+			return tok, sc
+		}
+		if !sc.isLambda() {
+			break
+		}
+	}
+	return nil, nil
+}
+
+// code returns the code and the parameters of sc according to the last program that parsed, ok is false for lambdas
+// and functions the program doesn't declare
+func (d *document) code(sc *scope) (code ast.Block, params ast.Set, ok bool) {
+	if sc.fun == nil {
+		return d.prog.Code, ast.EmptySet(), true
+	}
+	if sc.name == nil {
+		return nil, nil, false
+	}
+	for _, f := range d.prog.Funcs {
+		if f.Identifier == sc.name.IDValue() {
+			return f.Code, ast.SetFrom(f.Params...), true
+		}
+	}
+	return nil, nil, false
+}
+
+// funcDecl returns the declaration of the user-defined function name in the last program that parsed
+func (d *document) funcDecl(name string) (ast.FuncDecl, bool) {
+	for _, f := range d.prog.Funcs {
+		if f.Identifier == name {
+			return f, true
+		}
+	}
+	return ast.FuncDecl{}, false
+}
+
+// diagnostics describe the errors of the current text
+func (d *document) diagnostics() []diag.Diagnostic {
+	return diag.FromErrors("", d.text, d.err)
+}
+
+// position returns the position of offset, whose characters count UTF-16 code units
+func (d *document) position(offset int) position {
+	if offset > len(d.text) {
+		offset = len(d.text)
+	}
+	line := sort.Search(len(d.lines), func(i int) bool { return d.lines[i] > offset }) - 1
+	char := 0
+	for _, r := range string(d.text[d.lines[line]:offset]) {
+		char += len(utf16.Encode([]rune{r}))
+	}
+	return position{Line: line, Character: char}
+}
+
+// offset returns the offset of p, clamped to the line of p
+func (d *document) offset(p position) int {
+	if p.Line < 0 {
+		return 0
+	}
+	if p.Line >= len(d.lines) {
+		return len(d.text)
+	}
+	offset := d.lines[p.Line]
+	for char := 0; char < p.Character && offset < len(d.text) && d.text[offset] != '\n'; {
+		r, size := utf8.DecodeRune(d.text[offset:])
+		char += len(utf16.Encode([]rune{r}))
+		offset += size
+	}
+	return offset
+}
+
+func (d *document) rangeOf(start, end int) rng {
+	return rng{Start: d.position(start), End: d.position(end)}
+}
+
+func (d *document) tokenRange(tok *token.Token) rng {
+	return d.rangeOf(tok.Pos.Offset, tok.Pos.Offset+len(tok.Lit))
+}
@@ -0,0 +1,243 @@
+package lsp
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+// ident is an identifier of a document and what it refers to
+type ident struct {
+	tok     *token.Token
+	scope   *scope      // The innermost scope containing the identifier
+	fn      *scope      // The user-defined function declared or called, if any
+	builtin ast.Builtin // The built-in function called, if any
+}
+
+// identAt returns the identifier at the position of raw, which are textDocumentPositionParams
+func (s *Server) identAt(raw json.RawMessage) (*document, *ident, error) {
+	var params textDocumentPositionParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, nil, err
+	}
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil, nil, nil
+	}
+	i := doc.tokenAt(doc.offset(params.Position))
+	if i < 0 {
+		return doc, nil, nil
+	}
+	tok := doc.tokens[i]
+	id := &ident{tok: tok, scope: doc.scopeAt(tok.Pos.Offset)}
+	name := tok.IDValue()
+	switch {
+	case doc.is(i-1, tokFun):
+		id.fn = doc.funcs()[name]
+	case doc.is(i+1, tokLParen):
+		// Calls prefer user-defined functions over built-in ones over variables holding lambdas
+		if fn, ok := doc.funcs()[name]; ok {
+			id.fn = fn
+		} else if b, ok := s.ctx.FunctionMap[name]; ok {
+			id.builtin = b
+		}
+	}
+	return doc, id, nil
+}
+
+func (s *Server) hover(raw json.RawMessage) (interface{}, error) {
+	doc, id, err := s.identAt(raw)
+	if err != nil || id == nil {
+		return nil, err
+	}
+	var text string
+	switch {
+	case id.fn != nil:
+		text = funcHover(doc, id.fn)
+	case id.builtin != nil:
+		text = builtinHover(id.builtin)
+	default:
+		text = s.varHover(doc, id)
+	}
+	r := doc.tokenRange(id.tok)
+	return hover{Contents: markupContent{Kind: "markdown", Value: text}, Range: &r}, nil
+}
+
+func funcHover(doc *document, fn *scope) string {
+	text := codeBlock(fn.signature())
+	if comment := ast.DocComment(doc.text, fn.fun.Pos.Offset); comment != "" {
+		text += "\n" + comment
+	}
+	return text
+}
+
+func builtinHover(b ast.Builtin) string {
+	text := codeBlock(b.Name()+"(...)") + "\nBuilt-in function taking " + ast.Arity(b)
+	if b.Doc() != "" {
+		text += "\n\n" + b.Doc()
+	}
+	return text
+}
+
+func (s *Server) varHover(doc *document, id *ident) string {
+	name := id.tok.IDValue()
+	text := codeBlock(name)
+
+	def, defScope := doc.definition(id.scope, name)
+	isParam := false
+	if def != nil {
+		for _, p := range defScope.params {
+			isParam = isParam || p == def
+		}
+	}
+	switch {
+	case isParam:
+		text += "\nParameter of `" + defScope.signature() + "`"
+	case def != nil && defScope != id.scope:
+		text += "\nCaptured from the enclosing scope when the lambda is created"
+	case def == nil:
+		text += "\nNever assigned, hence always \"\""
+	}
+
+	if code, params, ok := doc.code(id.scope); ok && !isParam {
+		funcNames := s.ctx.FuncNames()
+		for _, f := range doc.prog.Funcs {
+			funcNames.Add(f.Identifier)
+		}
+		if ast.UsedBeforeDefVars(code, funcNames).Except(params).Contains(name) && def != nil {
+			text += "\n\nMay be read before it is assigned, it starts out as \"\""
+		}
+	}
+	return text
+}
+
+func (s *Server) definition(raw json.RawMessage) (interface{}, error) {
+	doc, id, err := s.identAt(raw)
+	if err != nil || id == nil {
+		return nil, err
+	}
+	var def *token.Token
+	switch {
+	case id.fn != nil:
+		def = id.fn.name
+	case id.builtin != nil:
+		return nil, nil
+	default:
+		def, _ = doc.definition(id.scope, id.tok.IDValue())
+	}
+	if def == nil {
+		return nil, nil
+	}
+	var params textDocumentPositionParams
+	json.Unmarshal(raw, &params)
+	return location{URI: params.TextDocument.URI, Range: doc.tokenRange(def)}, nil
+}
+
+func (s *Server) completion(raw json.RawMessage) (interface{}, error) {
+	var params textDocumentPositionParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, err
+	}
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil, nil
+	}
+
+	items := []completionItem{}
+	seen := make(ast.Set)
+	add := func(item completionItem) {
+		if !seen.Contains(item.Label) {
+			seen.Add(item.Label)
+			items = append(items, item)
+		}
+	}
+
+	// Local variables, including the ones lambdas capture from their enclosing scopes
+	for sc := doc.scopeAt(doc.offset(params.Position)); sc != nil; sc = sc.parent {
+		locals := make(ast.Set)
+		for _, p := range sc.params {
+			locals.Add(p.IDValue())
+		}
+		for name := range doc.assignments(sc) {
+			locals.Add(name)
+		}
+		if code, fnParams, ok := doc.code(sc); ok {
+			locals.Union(ast.DefinedVars(code)).Union(fnParams)
+		}
+		for _, name := range locals.Sorted() {
+			add(completionItem{Label: name, Kind: completionVariable})
+		}
+		if !sc.isLambda() {
+			break
+		}
+	}
+
+	funcs := doc.funcs()
+	names := make([]string, 0, len(funcs))
+	for name := range funcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		item := completionItem{Label: name, Kind: completionFunction, Detail: funcs[name].signature()}
+		if comment := ast.DocComment(doc.text, funcs[name].fun.Pos.Offset); comment != "" {
+			item.Documentation = &markupContent{Kind: "plaintext", Value: comment}
+		}
+		add(item)
+	}
+
+	for _, b := range s.ctx.Builtins() {
+		item := completionItem{Label: b.Name(), Kind: completionFunction, Detail: "built-in, " + ast.Arity(b)}
+		if b.Doc() != "" {
+			item.Documentation = &markupContent{Kind: "plaintext", Value: b.Doc()}
+		}
+		add(item)
+	}
+	return items, nil
+}
+
+func (s *Server) documentSymbol(raw json.RawMessage) (interface{}, error) {
+	var params documentSymbolParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, err
+	}
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok {
+		return nil, nil
+	}
+
+	symbols := []documentSymbol{}
+	for _, sc := range doc.scopes {
+		if sc.name != nil && sc.parent == doc.scopes[0] {
+			symbols = append(symbols, documentSymbol{
+				Name:           sc.name.IDValue(),
+				Detail:         sc.signature(),
+				Kind:           symbolFunction,
+				Range:          doc.rangeOf(sc.start, sc.end),
+				SelectionRange: doc.tokenRange(sc.name),
+			})
+		}
+	}
+	assigned := doc.assignments(doc.scopes[0])
+	for _, name := range ast.DefinedVars(doc.prog.Code).Sorted() {
+		if tok, ok := assigned[name]; ok {
+			symbols = append(symbols, documentSymbol{
+				Name:           name,
+				Kind:           symbolVariable,
+				Range:          doc.tokenRange(tok),
+				SelectionRange: doc.tokenRange(tok),
+			})
+		}
+	}
+	sort.SliceStable(symbols, func(i, j int) bool {
+		a, b := symbols[i].Range.Start, symbols[j].Range.Start
+		return a.Line < b.Line || a.Line == b.Line && a.Character < b.Character
+	})
+	return symbols, nil
+}
+
+func codeBlock(code string) string {
+	return "```stringlang\n" + code + "\n```"
+}
@@ -0,0 +1,197 @@
+// Package lsp implements a Language Server Protocol server for StringLang programs, see
+// https://microsoft.github.io/language-server-protocol/
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/cmd/stringlang/internal/wire"
+)
+
+// Server provides diagnostics, hovers, definitions, completions and symbols of StringLang documents for a client
+// speaking the Language Server Protocol. It handles one message at a time.
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	ctx         *ast.Context // Provides the built-in functions programs may call
+	docs        map[string]*document
+	initialized bool
+}
+
+// handler handles the parameters of a request and returns its result
+type handler func(s *Server, params json.RawMessage) (result interface{}, err error)
+
+// notificationHandler handles the parameters of a notification
+type notificationHandler func(s *Server, params json.RawMessage) error
+
+var (
+	handlers      map[string]handler
+	notifications map[string]notificationHandler
+)
+
+func init() {
+	handlers = map[string]handler{
+		"initialize":                  (*Server).initialize,
+		"shutdown":                    (*Server).shutdown,
+		"textDocument/hover":          (*Server).hover,
+		"textDocument/definition":     (*Server).definition,
+		"textDocument/completion":     (*Server).completion,
+		"textDocument/documentSymbol": (*Server).documentSymbol,
+	}
+	notifications = map[string]notificationHandler{
+		"textDocument/didOpen":   (*Server).didOpen,
+		"textDocument/didChange": (*Server).didChange,
+		"textDocument/didClose":  (*Server).didClose,
+	}
+}
+
+// NewServer returns a Server reading messages from in and writing to out, which knows the built-in functions of ctx
+func NewServer(in io.Reader, out io.Writer, ctx *ast.Context) *Server {
+	return &Server{
+		in:   bufio.NewReader(in),
+		out:  out,
+		ctx:  ctx,
+		docs: make(map[string]*document),
+	}
+}
+
+// Serve handles messages until the client exits or closes the connection
+func (s *Server) Serve() error {
+	for {
+		msg, err := readMessage(s.in)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		if msg.ID == nil {
+			// Notifications can't be answered, hence errors are dropped like unknown notifications
+			if h, ok := notifications[msg.Method]; ok && s.initialized {
+				h(s, msg.Params)
+			}
+			continue
+		}
+		if msg.Method == "" {
+			// A response to a request of the server, which doesn't send any
+			continue
+		}
+
+		h, ok := handlers[msg.Method]
+		switch {
+		case !ok:
+			err = s.respondError(msg, codeMethodNotFound, "unsupported method "+msg.Method)
+		case !s.initialized && msg.Method != "initialize":
+			err = s.respondError(msg, codeServerNotInitialized, "the server was not initialized")
+		default:
+			err = s.respond(msg, h)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) respond(req *message, h handler) error {
+	result, err := h(s, req.Params)
+	if err != nil {
+		code := codeInternalError
+		if _, ok := err.(*json.UnmarshalTypeError); ok {
+			code = codeInvalidParams
+		}
+		return s.respondError(req, code, err.Error())
+	}
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return s.respondError(req, codeInternalError, err.Error())
+	}
+	return wire.WriteMessage(s.out, response{JSONRPC: "2.0", ID: req.ID, Result: raw})
+}
+
+func (s *Server) respondError(req *message, code int, msg string) error {
+	return wire.WriteMessage(s.out, response{JSONRPC: "2.0", ID: req.ID, Error: &responseError{code, msg}})
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	return wire.WriteMessage(s.out, notification{JSONRPC: "2.0", Method: method, Params: params})
+}
+
+func (s *Server) initialize(_ json.RawMessage) (interface{}, error) {
+	s.initialized = true
+	return map[string]interface{}{
+		"capabilities": map[string]interface{}{
+			"textDocumentSync":       map[string]int{"change": 1, "openClose": 1}, // Full
+			"hoverProvider":          true,
+			"definitionProvider":     true,
+			"completionProvider":     map[string]interface{}{},
+			"documentSymbolProvider": true,
+		},
+		"serverInfo": map[string]string{"name": "stringlang"},
+	}, nil
+}
+
+func (s *Server) shutdown(_ json.RawMessage) (interface{}, error) {
+	return nil, nil
+}
+
+func (s *Server) didOpen(raw json.RawMessage) error {
+	var params didOpenParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return err
+	}
+	doc := new(document)
+	s.docs[params.TextDocument.URI] = doc
+	return s.update(params.TextDocument.URI, doc, params.TextDocument.Text)
+}
+
+func (s *Server) didChange(raw json.RawMessage) error {
+	var params didChangeParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return err
+	}
+	doc, ok := s.docs[params.TextDocument.URI]
+	if !ok || len(params.ContentChanges) == 0 {
+		return nil
+	}
+	// The server only supports full synchronization, the last change contains the whole text
+	return s.update(params.TextDocument.URI, doc, params.ContentChanges[len(params.ContentChanges)-1].Text)
+}
+
+func (s *Server) didClose(raw json.RawMessage) error {
+	var params didCloseParams
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return err
+	}
+	delete(s.docs, params.TextDocument.URI)
+	// Clear the diagnostics of the document
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         params.TextDocument.URI,
+		Diagnostics: []diagnostic{},
+	})
+}
+
+// update sets the text of doc and publishes its diagnostics
+func (s *Server) update(uri string, doc *document, text string) error {
+	doc.update([]byte(text))
+	diags := []diagnostic{}
+	if doc.err != nil {
+		for _, d := range doc.diagnostics() {
+			diags = append(diags, diagnostic{
+				Range:    doc.rangeOf(d.Span.Start.Offset, d.Span.End.Offset),
+				Severity: severityError,
+				Code:     d.Code,
+				Source:   "stringlang",
+				Message:  d.Message,
+			})
+		}
+	}
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: uri, Diagnostics: diags})
+}
@@ -0,0 +1,224 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/cmd/stringlang/internal/wire"
+)
+
+const testURI = "file:///greet.stringlang"
+
+const testDocument = `/* Greets name */
+fun greet(name) {
+	greeting = "Hello, " + name;
+	greeting
+}
+who = upper(%0);
+greet(who)
+`
+
+// client is a scripted Language Server Protocol client
+type client struct {
+	t    *testing.T
+	in   io.WriteCloser
+	id   int
+	msgs chan map[string]interface{}
+	done chan error
+}
+
+func newClient(t *testing.T) *client {
+	reqR, reqW := io.Pipe()
+	resR, resW := io.Pipe()
+	ctx := stringlang.NewContextBuiltins(nil, ast.BuiltinFunc{
+		Identifier:    "upper",
+		Min:           1,
+		Max:           1,
+		Documentation: "upper(s) returns s in upper case.",
+		Fn: func(_ *ast.CallContext, args []string) (string, error) {
+			return strings.ToUpper(args[0]), nil
+		},
+	})
+	s := NewServer(reqR, resW, ctx)
+
+	c := &client{t: t, in: reqW, msgs: make(chan map[string]interface{}, 100), done: make(chan error, 1)}
+	go func() {
+		c.done <- s.Serve()
+		resW.Close()
+	}()
+	go func() {
+		defer close(c.msgs)
+		out := bufio.NewReader(resR)
+		for {
+			raw, err := wire.ReadMessage(out)
+			if err != nil {
+				return
+			}
+			var msg map[string]interface{}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				t.Error(err)
+				return
+			}
+			c.msgs <- msg
+		}
+	}()
+	return c
+}
+
+// call sends a request and returns its response
+func (c *client) call(method string, params interface{}) map[string]interface{} {
+	c.id++
+	req := map[string]interface{}{"jsonrpc": "2.0", "id": c.id, "method": method, "params": params}
+	if err := wire.WriteMessage(c.in, req); err != nil {
+		c.t.Fatal(err)
+	}
+	return c.expect(func(msg map[string]interface{}) bool { return msg["id"] == float64(c.id) })
+}
+
+// request sends a request and returns its result, failing the test if it failed
+func (c *client) request(method string, params interface{}) interface{} {
+	res := c.call(method, params)
+	if res["error"] != nil {
+		c.t.Fatalf("%s failed: %v", method, res["error"])
+	}
+	return res["result"]
+}
+
+func (c *client) notify(method string, params interface{}) {
+	if err := wire.WriteMessage(c.in, map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+// expect returns the next message matching, skipping all other messages
+func (c *client) expect(matches func(msg map[string]interface{}) bool) map[string]interface{} {
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case msg, ok := <-c.msgs:
+			if !ok {
+				c.t.Fatal("server closed the connection")
+			}
+			if matches(msg) {
+				return msg
+			}
+		case <-timeout:
+			c.t.Fatal("timed out waiting for a message")
+		}
+	}
+}
+
+// diagnostics returns the messages of the next diagnostics published
+func (c *client) diagnostics() []string {
+	msg := c.expect(func(msg map[string]interface{}) bool { return msg["method"] == "textDocument/publishDiagnostics" })
+	params := msg["params"].(map[string]interface{})
+	if params["uri"] != testURI {
+		c.t.Errorf("got diagnostics for %v, want %s", params["uri"], testURI)
+	}
+	msgs := []string{}
+	for _, d := range params["diagnostics"].([]interface{}) {
+		msgs = append(msgs, d.(map[string]interface{})["message"].(string))
+	}
+	return msgs
+}
+
+func at(line, character int) map[string]interface{} {
+	return map[string]interface{}{
+		"textDocument": map[string]string{"uri": testURI},
+		"position":     map[string]int{"line": line, "character": character},
+	}
+}
+
+func TestLanguageServer(t *testing.T) {
+	c := newClient(t)
+	if res := c.call("textDocument/hover", at(0, 0)); res["error"] == nil {
+		t.Error("requests before initialize should fail")
+	}
+	caps := c.request("initialize", map[string]interface{}{}).(map[string]interface{})["capabilities"]
+	if caps.(map[string]interface{})["hoverProvider"] != true {
+		t.Errorf("hover should be supported, got capabilities %v", caps)
+	}
+	if res := c.call("textDocument/formatting", nil); res["error"].(map[string]interface{})["code"] != float64(codeMethodNotFound) {
+		t.Errorf("unsupported method: got %v", res)
+	}
+
+	c.notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]string{"uri": testURI, "text": "x = ("},
+	})
+	if diags := c.diagnostics(); len(diags) != 1 {
+		t.Errorf("got diagnostics %q, want one syntax error", diags)
+	}
+	c.notify("textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]string{"uri": testURI},
+		"contentChanges": []map[string]string{{"text": testDocument}},
+	})
+	if diags := c.diagnostics(); len(diags) != 0 {
+		t.Errorf("got diagnostics %q for a valid document", diags)
+	}
+
+	hovers := []struct {
+		line, character int
+		want            string
+	}{
+		{6, 1, "```stringlang\nfun greet(name)\n```\nGreets name"},
+		{5, 7, "```stringlang\nupper(...)\n```\nBuilt-in function taking 1 argument\n\nupper(s) returns s in upper case."},
+		{2, 25, "```stringlang\nname\n```\nParameter of `fun greet(name)`"},
+		{6, 7, "```stringlang\nwho\n```"},
+	}
+	for _, h := range hovers {
+		res := c.request("textDocument/hover", at(h.line, h.character)).(map[string]interface{})
+		if got := res["contents"].(map[string]interface{})["value"]; got != h.want {
+			t.Errorf("hover at %d:%d: got %q, want %q", h.line, h.character, got, h.want)
+		}
+	}
+
+	loc := c.request("textDocument/definition", at(6, 7)).(map[string]interface{})
+	wantRange := map[string]interface{}{
+		"start": map[string]interface{}{"line": 5.0, "character": 0.0},
+		"end":   map[string]interface{}{"line": 5.0, "character": 3.0},
+	}
+	if loc["uri"] != testURI || !reflect.DeepEqual(loc["range"], wantRange) {
+		t.Errorf("definition of who: got %v", loc)
+	}
+	if def := c.request("textDocument/definition", at(5, 7)); def != nil {
+		t.Errorf("definition of a built-in function: got %v, want none", def)
+	}
+
+	labels := []string{}
+	for _, item := range c.request("textDocument/completion", at(3, 1)).([]interface{}) {
+		labels = append(labels, item.(map[string]interface{})["label"].(string))
+	}
+	if want := []string{"greeting", "name", "greet", "upper"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("completion in greet: got %q, want %q", labels, want)
+	}
+
+	symbols := []string{}
+	params := map[string]interface{}{"textDocument": map[string]string{"uri": testURI}}
+	for _, sym := range c.request("textDocument/documentSymbol", params).([]interface{}) {
+		symbols = append(symbols, sym.(map[string]interface{})["name"].(string))
+	}
+	if want := []string{"greet", "who"}; !reflect.DeepEqual(symbols, want) {
+		t.Errorf("got symbols %q, want %q", symbols, want)
+	}
+
+	c.notify("textDocument/didClose", params)
+	if diags := c.diagnostics(); len(diags) != 0 {
+		t.Errorf("closing should clear the diagnostics, got %q", diags)
+	}
+	if res := c.request("textDocument/hover", at(6, 1)); res != nil {
+		t.Errorf("hover in a closed document: got %v", res)
+	}
+
+	c.request("shutdown", nil)
+	c.notify("exit", nil)
+	if err := <-c.done; err != nil {
+		t.Errorf("serving: %v", err)
+	}
+}
@@ -1,21 +1,35 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"github.com/skius/stringlang"
 	"github.com/skius/stringlang/ast"
 	"github.com/skius/stringlang/cfg"
 	"github.com/skius/stringlang/cmd/stringlang/repl"
+	"github.com/skius/stringlang/diag"
 	"github.com/skius/stringlang/optimizer"
 	"github.com/skius/stringlang/optimizer/analysis/liveness"
 	"github.com/skius/stringlang/optimizer/analysis/sideeffect"
 	"github.com/skius/stringlang/optimizer/analysis/util"
 	"io/ioutil"
+	"os"
 	"time"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		if run, ok := subcommands[os.Args[1]]; ok {
+			if err := run(os.Args[2:]); err != nil {
+				fmt.Fprintln(os.Stderr, "stringlang "+os.Args[1]+":", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	var normalize bool
 	flag.BoolVar(&normalize, "normalize", false, "Normalize program")
 
@@ -31,11 +45,25 @@ func main() {
 	var livenessAnalysis bool
 	flag.BoolVar(&livenessAnalysis, "liveness", false, "Print results of liveness analysis [forces --normalize]")
 
+	var seed int64
+	flag.Int64Var(&seed, "seed", 0, "Seed the random number generator of the program with argument")
+
+	var recordFile string
+	flag.StringVar(&recordFile, "record", "", "Record nondeterministic built-in function calls as JSON to argument")
+
+	var replayFile string
+	flag.StringVar(&replayFile, "replay", "", "Replay nondeterministic built-in function calls recorded in argument")
+
+	var format string
+	flag.StringVar(&format, "format", formatText, formatUsage+" to stderr")
+
 	flag.Parse()
 
-	anyFlagSet := false
+	anyFlagSet, seedSet := false, false
 	flag.Visit(func(f *flag.Flag) {
 		anyFlagSet = true
+		// Any seed is valid, including the default 0
+		seedSet = seedSet || f.Name == "seed"
 	})
 
 	if !anyFlagSet && len(flag.Args()) == 0 {
@@ -50,18 +78,34 @@ func main() {
 		return
 	}
 
+	if err := checkFormat(format); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
 	sourceFile := flag.Args()[0]
 	source, err := ioutil.ReadFile(sourceFile)
 	if err != nil {
 		panic(err)
 	}
 
-	expr, err := stringlang.Parse(source)
+	// fail reports an error of parsing or evaluating the program
+	fail := func(err error) {
+		ds := diag.FromErrors(sourceFile, source, err)
+		if err := writeDiagnostics(os.Stderr, format, tool, ds, map[string][]byte{sourceFile: source}); err != nil {
+			panic(err)
+		}
+		os.Exit(1)
+	}
+
+	// Recovering from syntax errors to report all of them
+	expr, err := stringlang.ParseRecover(source)
 	if err != nil {
-		panic(sourceFile + ":" + err.Error())
+		fail(err)
 	}
 
 	program := expr.(ast.Program)
+	ctx := stringlang.ExampleContext(true)
 
 	if sideffectAnalysis {
 		// Forces normalize
@@ -71,7 +115,12 @@ func main() {
 		fmt.Println("Side-effect Analysis:")
 		// TODO memoize normalization results, graphs etc using functions
 		g, _ := cfg.New(program)
-		in, out := sideeffect.Compute(g)
+		// The functions of the program aren't in ctx yet, but shadow the built-in functions of the same name
+		pure := ctx.PureFuncs()
+		for _, f := range program.Funcs {
+			delete(pure, f.Identifier)
+		}
+		in, out := sideeffect.Compute(g, util.Set(pure))
 		str := util.PrettyPrintFlows(g, in, out)
 		fmt.Println(str)
 		fmt.Println()
@@ -113,9 +162,39 @@ func main() {
 		return
 	}
 
-	result, err := stringlang.EvalOrTimeout(stringlang.ExampleContext(true), program, time.Second*30)
+	if seedSet {
+		ctx.SetSeed(seed)
+	}
+	recording := &ast.Recording{}
+	if recordFile != "" {
+		ctx.RecordTo(recording)
+	}
+	if replayFile != "" {
+		data, err := ioutil.ReadFile(replayFile)
+		if err != nil {
+			panic(err)
+		}
+		if err := json.Unmarshal(data, recording); err != nil {
+			panic(replayFile + ":" + err.Error())
+		}
+		ctx.ReplayFrom(recording)
+	}
+
+	goCtx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	result, err := stringlang.EvalContext(goCtx, ctx, program)
+	if recordFile != "" {
+		// Failing runs are the interesting ones to reproduce, hence write the recording first
+		data, err := json.MarshalIndent(recording, "", "  ")
+		if err != nil {
+			panic(err)
+		}
+		if err := ioutil.WriteFile(recordFile, data, 0666); err != nil {
+			panic(err)
+		}
+	}
 	if err != nil {
-		panic(err)
+		fail(err)
 	}
 
 	fmt.Println("Returns:")
@@ -0,0 +1,142 @@
+package repl
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A command is a line starting with ':' entered at the beginning of a new expression, e.g. ":doc length"
+type command struct {
+	usage string
+	help  string
+	run   func(r *Repl, args []string)
+}
+
+var commands map[string]command
+
+func init() {
+	commands = map[string]command{
+		"doc": {
+			usage: ":doc [name]",
+			help:  "Show the documentation of all built-in functions or only the one called name",
+			run:   (*Repl).cmdDoc,
+		},
+		"help": {
+			usage: ":help",
+			help:  "Show this list of commands",
+			run:   (*Repl).cmdHelp,
+		},
+		"list": {
+			usage: ":list",
+			help:  "Show the code run so far with line numbers",
+			run:   (*Repl).cmdList,
+		},
+		"break": {
+			usage: ":break [fn|line]",
+			help:  "Pause programs whenever they call the function fn or reach line, or show all breakpoints",
+			run:   (*Repl).cmdBreak,
+		},
+		"clear": {
+			usage: ":clear [fn|line]",
+			help:  "Remove the breakpoint of fn or line, or all breakpoints",
+			run:   (*Repl).cmdClear,
+		},
+		"step": {
+			usage: ":step",
+			help:  "Continue the paused program until the next statement, stepping into calls",
+			run:   (*Repl).cmdStep,
+		},
+		"next": {
+			usage: ":next",
+			help:  "Continue the paused program until the next statement, stepping over calls",
+			run:   (*Repl).cmdNext,
+		},
+		"continue": {
+			usage: ":continue",
+			help:  "Continue the paused program until the next breakpoint",
+			run:   (*Repl).cmdContinue,
+		},
+		"abort": {
+			usage: ":abort",
+			help:  "Stop the paused program",
+			run:   (*Repl).cmdAbort,
+		},
+		"bt": {
+			usage: ":bt",
+			help:  "Show the frames of the paused program, the innermost first",
+			run:   (*Repl).cmdBacktrace,
+		},
+		"frame": {
+			usage: ":frame n",
+			help:  "Select frame n of the paused program (see :bt) for :locals and :set",
+			run:   (*Repl).cmdFrame,
+		},
+		"locals": {
+			usage: ":locals",
+			help:  "Show the variables of the selected frame",
+			run:   (*Repl).cmdLocals,
+		},
+		"set": {
+			usage: ":set name value",
+			help:  "Assign value, a string literal, to the variable name of the selected frame",
+			run:   (*Repl).cmdSet,
+		},
+	}
+}
+
+func isCommand(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), ":")
+}
+
+// RunCommand runs the command on the given line
+func (r *Repl) RunCommand(line string) {
+	fields := strings.Fields(strings.TrimPrefix(strings.TrimSpace(line), ":"))
+	if len(fields) == 0 {
+		r.cmdHelp(nil)
+		return
+	}
+	cmd, ok := commands[fields[0]]
+	if !ok {
+		r.T.PrintLn("Unknown command ':" + fields[0] + "', see ':help'.")
+		return
+	}
+	cmd.run(r, fields[1:])
+}
+
+func (r *Repl) cmdHelp(_ []string) {
+	for _, name := range sortedCommandNames() {
+		cmd := commands[name]
+		r.T.PrintLn(r.T.Color(Cyan) + cmd.usage + r.T.ResetColor() + "\t" + cmd.help)
+	}
+}
+
+func (r *Repl) cmdDoc(args []string) {
+	for _, b := range r.Context.Builtins() {
+		if len(args) > 0 && b.Name() != args[0] {
+			continue
+		}
+		doc := b.Doc()
+		if doc == "" {
+			doc = "(undocumented)"
+		}
+		r.T.PrintLn(r.T.Color(Cyan) + b.Name() + r.T.ResetColor() + "\t" + doc)
+		if len(args) > 0 {
+			return
+		}
+	}
+	if len(args) > 0 {
+		r.T.PrintLn("There is no built-in function called '" + args[0] + "'.")
+	}
+}
+
+func (r *Repl) cmdList(_ []string) {
+	lines := strings.Split(strings.TrimSuffix(r.Source, "\n"), "\n")
+	if r.Source == "" {
+		return
+	}
+	width := len(strconv.Itoa(len(lines)))
+	for i, line := range lines {
+		num := strconv.Itoa(i + 1)
+		r.T.PrintLn(r.T.Color(Gray) + genSpaces(width-len(num)) + num + r.T.ResetColor() + "  " + line)
+	}
+}
@@ -0,0 +1,184 @@
+package repl
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/debugger"
+)
+
+// onStop is called by the debugger whenever the program pauses, it runs commands until one of them continues
+func (r *Repl) onStop(s *debugger.Stop) debugger.Action {
+	r.stop = s
+	r.frame = 0
+	r.resume = nil
+	defer func() {
+		r.stop = nil
+	}()
+
+	r.printStop()
+	for r.resume == nil {
+		r.T.SetMultiLine(false)
+		r.T.SetIndent(0)
+		line := r.T.ReadLn()
+		if isCommand(line) {
+			r.RunCommand(line)
+			continue
+		}
+		if strings.TrimSpace(line) != "" {
+			r.T.PrintLn("The program is paused, continue it using ':step', ':next' or ':continue', or stop it " +
+				"using ':abort'.")
+		}
+	}
+	return *r.resume
+}
+
+func (r *Repl) printStop() {
+	f := r.stop.Frames[0]
+	where := "in " + f.Name
+	if f.Pos.IsValid() {
+		where = "at line " + strconv.Itoa(f.Pos.Line) + " " + where
+	}
+	r.T.PrintLn("Paused " + where + " (" + r.stop.Reason.String() + "):")
+	r.T.PrintLn(r.T.Color(Cyan) + f.Stmt.String() + r.T.ResetColor())
+}
+
+// frameContext returns the frame selected using :frame, or the top level if the program isn't paused
+func (r *Repl) frameContext() *ast.Context {
+	if r.stop == nil {
+		return r.Context
+	}
+	return r.stop.Frames[r.frame].Context
+}
+
+func (r *Repl) continueWith(a debugger.Action) {
+	if r.stop == nil {
+		if a == debugger.Continue {
+			r.T.PrintLn("The program isn't paused.")
+			return
+		}
+		// Pause the next program right away
+		r.Debugger.Step(a)
+		r.T.PrintLn("The next program will pause at its first statement.")
+		return
+	}
+	r.resume = &a
+}
+
+func (r *Repl) cmdStep(_ []string) {
+	r.continueWith(debugger.StepIn)
+}
+
+func (r *Repl) cmdNext(_ []string) {
+	r.continueWith(debugger.StepOver)
+}
+
+func (r *Repl) cmdContinue(_ []string) {
+	r.continueWith(debugger.Continue)
+}
+
+func (r *Repl) cmdAbort(_ []string) {
+	if r.stop == nil {
+		r.T.PrintLn("The program isn't paused.")
+		return
+	}
+	r.cancel()
+	r.continueWith(debugger.Continue)
+}
+
+func (r *Repl) cmdBreak(args []string) {
+	if len(args) == 0 {
+		for _, name := range r.Debugger.FuncBreakpoints() {
+			r.T.PrintLn("Breakpoint at function " + name)
+		}
+		for _, line := range r.Debugger.LineBreakpoints() {
+			r.T.PrintLn("Breakpoint at line " + strconv.Itoa(line))
+		}
+		return
+	}
+	if line, err := strconv.Atoi(args[0]); err == nil {
+		r.Debugger.BreakLine(line)
+		r.T.PrintLn("Breakpoint at line " + args[0])
+		return
+	}
+	r.Debugger.BreakFunc(args[0])
+	r.T.PrintLn("Breakpoint at function " + args[0])
+}
+
+func (r *Repl) cmdClear(args []string) {
+	if len(args) == 0 {
+		r.Debugger.ClearAll()
+		r.T.PrintLn("Removed all breakpoints.")
+		return
+	}
+	if line, err := strconv.Atoi(args[0]); err == nil {
+		r.Debugger.ClearLine(line)
+	} else {
+		r.Debugger.ClearFunc(args[0])
+	}
+	r.T.PrintLn("Removed breakpoint at " + args[0])
+}
+
+func (r *Repl) cmdBacktrace(_ []string) {
+	if r.stop == nil {
+		r.T.PrintLn("The program isn't paused.")
+		return
+	}
+	for i, f := range r.stop.Frames {
+		marker := "  "
+		if i == r.frame {
+			marker = "* "
+		}
+		stmt := strings.SplitN(f.Stmt.String(), "\n", 2)[0]
+		r.T.PrintLn(marker + "#" + strconv.Itoa(i) + " " + f.Name + " at " + f.Pos.String() + ": " + stmt)
+	}
+}
+
+func (r *Repl) cmdFrame(args []string) {
+	if r.stop == nil {
+		r.T.PrintLn("The program isn't paused.")
+		return
+	}
+	if len(args) == 0 {
+		r.T.PrintLn("Usage: :frame n")
+		return
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil || n < 0 || n >= len(r.stop.Frames) {
+		r.T.PrintLn("There is no frame " + args[0] + ", see ':bt'.")
+		return
+	}
+	r.frame = n
+	r.cmdBacktrace(nil)
+}
+
+func (r *Repl) cmdLocals(_ []string) {
+	vars := r.frameContext().VariableMap
+	names := make([]string, 0, len(vars))
+	for v := range vars {
+		names = append(names, string(v))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		r.T.PrintLn(name + " = " + strconv.Quote(string(vars[ast.Var(name)])))
+	}
+}
+
+func (r *Repl) cmdSet(args []string) {
+	if len(args) < 2 {
+		r.T.PrintLn("Usage: :set name value")
+		return
+	}
+	value := strings.Join(args[1:], " ")
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			r.T.PrintLn("Invalid string literal " + value)
+			return
+		}
+		value = unquoted
+	}
+	r.frameContext().Set(args[0], value)
+}
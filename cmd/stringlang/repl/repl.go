@@ -1,10 +1,11 @@
 package repl
 
 import (
+	"context"
 	"github.com/skius/stringlang"
 	"github.com/skius/stringlang/ast"
-	"github.com/skius/stringlang/internal/frontend/errors"
-	"github.com/skius/stringlang/internal/frontend/token"
+	"github.com/skius/stringlang/debugger"
+	"github.com/skius/stringlang/diag"
 	"strings"
 	"time"
 )
@@ -15,6 +16,13 @@ type Repl struct {
 	IndentLevel  int
 	PartialParse string
 	T            Terminal
+	Debugger     *debugger.Debugger
+	Source       string // All code run so far, line numbers (e.g. of breakpoints) refer to it
+
+	stop   *debugger.Stop     // Where the program is paused, nil if it isn't
+	frame  int                // Index of the frame of stop selected using :frame
+	resume *debugger.Action   // How to continue the paused program once a command decided it
+	cancel context.CancelFunc // Stops the running program
 }
 
 func Init(t Terminal) *Repl {
@@ -24,6 +32,7 @@ func Init(t Terminal) *Repl {
 	r.IndentLevel = 0
 	r.PartialParse = ""
 	r.T = t
+	r.Debugger = debugger.New(r.onStop)
 	return r
 }
 
@@ -32,6 +41,7 @@ func (r *Repl) PrintWelcome() {
 	r.T.PrintLn("Enter code, run it by pressing ENTER, repeat!")
 	r.T.PrintLn("The special variable '_' can be used to refer to the previous result.")
 	r.T.PrintLn("Reset your program using 'reset;;' and quit the REPL using 'quit;;' or Ctrl-C.")
+	r.T.PrintLn("Commands like ':doc' start with ':', see ':help' for all of them.")
 }
 
 func (r *Repl) ResetPartial() {
@@ -43,6 +53,8 @@ func (r *Repl) FullReset() {
 	r.T.PrintLn("Resetting REPL... Reset!")
 	r.UserFuncs = []ast.FuncDecl{}
 	r.Context = stringlang.ExampleContext(false)
+	r.Source = ""
+	r.Debugger.ClearAll()
 	r.ResetPartial()
 }
 
@@ -88,30 +100,34 @@ func (r *Repl) ReadExpr() (expr stringlang.Expr, reset bool, quit bool) {
 			return nil, false, true
 		}
 
+		if r.IsNewPartialParse() && isCommand(line) {
+			r.RunCommand(line)
+			continue
+		}
+
 		r.UpdateIndent(line)
 
 		r.PartialParse += line
 
 		var err error
-		expr, err = stringlang.Parse([]byte(r.PartialParse))
+		// Pad with the lines entered before, so positions refer to the whole session
+		padding := strings.Repeat("\n", strings.Count(r.Source, "\n"))
+		expr, err = stringlang.Parse([]byte(padding + r.PartialParse))
 		if err == nil {
 			// newExpr got successfully parsed into a program, let's execute it
+			r.Source += r.PartialParse
 			return expr, false, false
 		}
-		pErr := err.(*errors.Error)
-		if pErr.ErrorToken.Type == token.EOF {
-			// If err is of the form "expected <something>; got: end-of-file", we know the program was potentially
-			// correct, just incomplete, so we should keep reading
-			continue
-		}
-		unexpectedToken := pErr.ErrorToken.Lit
-		if strings.HasPrefix(string(unexpectedToken), `"`) {
-			// Start of a multiline string, keep reading
+		if sErr, ok := err.(*stringlang.SyntaxError); ok && sErr.Incomplete {
+			// The program ended early, e.g. "expected <something>, found the end of the program" or within a
+			// multiline string, so it is potentially correct, just incomplete, and we should keep reading
 			continue
 		}
 
 		// Otherwise there's no chance the program could become correct, so we have to reset this expression
-		r.T.PrintLn("There was an error parsing your input: ", err)
+		src := []byte(padding + r.PartialParse)
+		r.T.PrintLn("There was an error parsing your input:")
+		r.T.PrintLn(strings.TrimSuffix(diag.FromError("", src, err).Render(src), "\n"))
 		r.ResetPartial()
 	}
 }
@@ -143,7 +159,17 @@ func (r *Repl) Run() {
 		}
 
 		// Eval by reusing context, so we store previous computations
-		result, err := stringlang.EvalOrTimeout(r.Context, prog, time.Second*5)
+		var goCtx context.Context
+		if r.Debugger.Active() {
+			// The program may pause, hence it mustn't time out
+			r.Context.SetHooks(r.Debugger)
+			goCtx, r.cancel = context.WithCancel(context.Background())
+		} else {
+			r.Context.SetHooks(nil)
+			goCtx, r.cancel = context.WithTimeout(context.Background(), time.Second*5)
+		}
+		result, err := stringlang.EvalContext(goCtx, r.Context, prog)
+		r.cancel()
 		if err != nil {
 			t.PrintLn("There was an error running your program: ", err)
 			continue
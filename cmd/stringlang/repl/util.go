@@ -1,6 +1,9 @@
 package repl
 
-import "strings"
+import (
+	"sort"
+	"strings"
+)
 
 func genSpaces(i int) string {
 	s := ""
@@ -13,3 +16,12 @@ func genSpaces(i int) string {
 func isCmd(s, cmd string) bool {
 	return strings.HasSuffix(s, cmd+";;")
 }
+
+func sortedCommandNames() []string {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
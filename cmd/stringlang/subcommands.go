@@ -0,0 +1,28 @@
+package main
+
+import (
+	"os"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/cmd/stringlang/dap"
+	"github.com/skius/stringlang/cmd/stringlang/lsp"
+)
+
+// subcommands are run using "stringlang <name> <args...>" instead of running a program
+var subcommands = map[string]func(args []string) error{
+	"ast":  runAST,
+	"dap":  runDAP,
+	"fmt":  runFmt,
+	"lint": runLint,
+	"lsp":  runLSP,
+}
+
+// runDAP serves the Debug Adapter Protocol over stdin and stdout, e.g. for editors
+func runDAP(_ []string) error {
+	return dap.NewServer(os.Stdin, os.Stdout).Serve()
+}
+
+// runLSP serves the Language Server Protocol over stdin and stdout, knowing the built-in functions of the interpreter
+func runLSP(_ []string) error {
+	return lsp.NewServer(os.Stdin, os.Stdout, stringlang.ExampleContext(false)).Serve()
+}
@@ -0,0 +1,98 @@
+package stringlang
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/skius/stringlang/ast"
+)
+
+// Compiled is a parsed program that can be run any number of times. It is never modified after Compile, hence it is
+// safe to share between goroutines and to run concurrently, every run gets its own variables and limits.
+type Compiled struct {
+	prog  ast.Program
+	funcs map[string]ast.FuncDecl
+	info  ast.ProgramInfo
+}
+
+// RunOptions configure a single run of a Compiled program
+type RunOptions struct {
+	Args     []string  // Arguments of the program, i.e. the values of %0, %1, ...
+	Builtins []Builtin // Built-in functions, must be safe for concurrent use if shared
+	Limits   ast.Limits
+	Policy   *ast.Policy      // Restrictions for untrusted programs, nil for none
+	Seed     *int64           // Seed of the random number generator, nil for a random one
+	Clock    func() time.Time // Clock built-in functions use, nil for time.Now
+	Record   *ast.Recording   // If non-nil, nondeterministic built-in function calls are recorded to it
+	Replay   *ast.Recording   // If non-nil, nondeterministic built-in function calls are replayed from it
+	// CanonicalLambdas makes alpha-equivalent lambdas equal values, see ast.Context.SetCanonicalLambdas
+	CanonicalLambdas bool
+}
+
+// Compile parses src into a program that can be run concurrently
+func Compile(src []byte) (*Compiled, error) {
+	expr, err := Parse(src)
+	if err != nil {
+		return nil, err
+	}
+	prog, ok := expr.(ast.Program)
+	if !ok {
+		return nil, errors.New("couldn't cast parsing result to Program")
+	}
+
+	funcs := make(map[string]ast.FuncDecl, len(prog.Funcs))
+	for _, f := range prog.Funcs {
+		funcs[f.Identifier] = f
+	}
+	return &Compiled{prog: prog, funcs: funcs, info: prog.Info()}, nil
+}
+
+// Program returns the parsed program
+func (c *Compiled) Program() ast.Program {
+	return c.prog
+}
+
+// Info returns the description of the program, see ast.Program.Info
+func (c *Compiled) Info() ast.ProgramInfo {
+	return c.info
+}
+
+// Run evaluates the program with a fresh Context, see EvalContext
+func (c *Compiled) Run(goCtx context.Context, opts RunOptions) (string, error) {
+	ctx := c.NewContext(opts)
+	// The policy needs to be checked against the whole program, including its functions
+	if err := ctx.CheckPolicy(c.prog); err != nil {
+		return "", err
+	}
+	return EvalContext(goCtx, ctx, c.prog.Code)
+}
+
+// NewContext returns a fresh Context which knows the functions of the program, but hasn't evaluated its code yet.
+// Use it to keep the variables of a run around, e.g. to call functions of the program from Go afterwards:
+//
+//	ctx := compiled.NewContext(opts)
+//	_, err := stringlang.EvalContext(goCtx, ctx, compiled.Program().Code)
+//	...
+//	reply, err := ctx.Call("on_message", user, text)
+func (c *Compiled) NewContext(opts RunOptions) *Context {
+	ctx := NewContextBuiltins(opts.Args, opts.Builtins...)
+	// Evaluation may add user functions to the Context (e.g. using eval), so every run gets its own copy
+	for id, f := range c.funcs {
+		ctx.UserFunctionMap[id] = f
+	}
+	ctx.SetLimits(opts.Limits)
+	ctx.SetPolicy(opts.Policy)
+	if opts.Seed != nil {
+		ctx.SetSeed(*opts.Seed)
+	}
+	ctx.SetClock(opts.Clock)
+	if opts.Record != nil {
+		ctx.RecordTo(opts.Record)
+	}
+	if opts.Replay != nil {
+		ctx.ReplayFrom(opts.Replay)
+	}
+	ctx.SetCanonicalLambdas(opts.CanonicalLambdas)
+	return ctx
+}
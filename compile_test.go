@@ -0,0 +1,114 @@
+package stringlang_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+const concurrentSource = `
+fun repeat(s, n) {
+	res = "";
+	cnt = "";
+	while (length(cnt) != n) {
+		res = res + s;
+		cnt = cnt + "x"
+	};
+	res
+}
+
+n = %1;
+suffix = fun(x) { x + n };
+suffix(repeat(%0, %1))
+`
+
+func TestCompiledRunConcurrently(t *testing.T) {
+	compiled, err := stringlang.Compile([]byte(concurrentSource))
+	if err != nil {
+		t.Fatal(err)
+	}
+	builtins := []stringlang.Builtin{stringlang.BuiltinFunc{
+		Identifier: "length",
+		Min:        1,
+		Max:        1,
+		IsPure:     true,
+		Fn: func(_ *stringlang.CallContext, args []string) (string, error) {
+			return strconv.Itoa(len(args[0])), nil
+		},
+	}}
+
+	const runs = 2000
+	var wg sync.WaitGroup
+	errs := make(chan string, runs)
+	for i := 0; i < runs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			s := strconv.Itoa(i % 10)
+			n := i % 7
+			res, err := compiled.Run(context.Background(), stringlang.RunOptions{
+				Args:     []string{s, strconv.Itoa(n)},
+				Builtins: builtins,
+				Limits:   ast.Limits{MaxMemory: 1024 * 1024},
+			})
+			want := ""
+			for j := 0; j < n; j++ {
+				want += s
+			}
+			want += strconv.Itoa(n)
+			if err != nil || res != want {
+				errs <- "run " + strconv.Itoa(i) + ": got " + strconv.Quote(res) + ", want " + strconv.Quote(want)
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Error(e)
+	}
+}
+
+func TestCompiledRunLimits(t *testing.T) {
+	compiled, err := stringlang.Compile([]byte(`fun f(x) { f(x + "x") } f("")`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = compiled.Run(context.Background(), stringlang.RunOptions{Limits: ast.Limits{MaxCallDepth: 10}})
+	if err != ast.ErrRecursionLimit {
+		t.Errorf("got error %v, want %v", err, ast.ErrRecursionLimit)
+	}
+	_, err = compiled.Run(context.Background(), stringlang.RunOptions{Limits: ast.Limits{MaxMemory: 64 * 1024}})
+	if err != ast.ErrMemoryLimitExceeded {
+		t.Errorf("got error %v, want %v", err, ast.ErrMemoryLimitExceeded)
+	}
+}
+
+func TestCompiledRunSeed(t *testing.T) {
+	compiled, err := stringlang.Compile([]byte(`random() + " " + random()`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	builtins := []stringlang.Builtin{stringlang.BuiltinFunc{
+		Identifier: "random",
+		Fn: func(cc *stringlang.CallContext, args []string) (string, error) {
+			return strconv.Itoa(cc.Rand().Intn(1000000)), nil
+		},
+	}}
+	// 0 is a seed like any other
+	for _, seed := range []int64{0, 7} {
+		var results [2]string
+		for i := range results {
+			results[i], err = compiled.Run(context.Background(), stringlang.RunOptions{Builtins: builtins, Seed: &seed})
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if results[0] != results[1] {
+			t.Errorf("seed %d: got %q and %q", seed, results[0], results[1])
+		}
+	}
+}
@@ -0,0 +1,320 @@
+// Package debugger implements breakpoints and stepping through StringLang evaluations on top of ast.Hooks. Frontends
+// like the REPL or the Debug Adapter Protocol server decide what to do whenever the evaluation stops.
+package debugger
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/skius/stringlang/ast"
+)
+
+// TopLevel is the name of the frame evaluating the code of the program itself
+const TopLevel = "<top level>"
+
+// Action tells the Debugger how to continue after a stop
+type Action int
+
+const (
+	Continue Action = iota // Run until the next breakpoint
+	StepIn                 // Stop at the next statement
+	StepOver               // Stop at the next statement of the current frame or one of its callers
+	StepOut                // Stop at the next statement of a caller of the current frame
+)
+
+// Reason is the reason the evaluation stopped
+type Reason int
+
+const (
+	StepReason Reason = iota
+	BreakpointReason
+	FunctionBreakpointReason
+	PauseReason
+)
+
+// String returns the name of the reason as used by the Debug Adapter Protocol
+func (r Reason) String() string {
+	switch r {
+	case StepReason:
+		return "step"
+	case BreakpointReason:
+		return "breakpoint"
+	case FunctionBreakpointReason:
+		return "function breakpoint"
+	case PauseReason:
+		return "pause"
+	}
+	return "unknown"
+}
+
+// Frame is a frame of the evaluation being debugged, i.e. the top level or a call of a user-defined function or lambda
+type Frame struct {
+	Name    string       // Name of the function, or TopLevel
+	Kind    ast.CallKind // Kind of function, meaningless for the top level
+	Context *ast.Context // The frame, its variables are in Context.VariableMap
+	Stmt    ast.Expr     // The statement being evaluated
+	Pos     ast.Pos      // Position of Stmt, or of the last statement before it with a known position
+
+	line int // Line of the last statement considered for line breakpoints, 0 once a loop iteration starts
+}
+
+// Stop describes where the evaluation stopped
+type Stop struct {
+	Reason Reason
+	Frames []*Frame // The innermost frame first
+}
+
+// Debugger stops evaluations at breakpoints and while stepping, install it using ast.Context.SetHooks. It debugs one
+// evaluation at a time, but breakpoints may be changed and pauses requested from any goroutine.
+type Debugger struct {
+	ast.NopHooks
+
+	// OnStop is called on the evaluating goroutine whenever the evaluation stops and decides how to continue. It may
+	// inspect and modify the variables of all frames, but must not evaluate code using them.
+	OnStop func(s *Stop) Action
+
+	mu         sync.Mutex // Guards the breakpoints and pause
+	funcBreaks map[string]bool
+	lineBreaks map[int]bool
+	pause      bool // Stop at the next statement, see Pause
+
+	frames      []*Frame
+	exprs       []ast.Expr // The expressions being evaluated, the innermost last
+	action      Action
+	actionDepth int  // Number of frames when action was chosen
+	funcHit     bool // A function breakpoint was hit, stop at the first statement of its frame
+	stopped     bool // Whether OnStop is running
+}
+
+// New returns a Debugger calling onStop whenever the evaluation stops
+func New(onStop func(s *Stop) Action) *Debugger {
+	return &Debugger{
+		OnStop:     onStop,
+		funcBreaks: make(map[string]bool),
+		lineBreaks: make(map[int]bool),
+	}
+}
+
+// BreakFunc stops evaluations at the first statement of every call of the user-defined function name. Lambdas are
+// named by the expression they are called with, e.g. the variable holding them.
+func (d *Debugger) BreakFunc(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.funcBreaks[name] = true
+}
+
+// ClearFunc removes the breakpoint of BreakFunc
+func (d *Debugger) ClearFunc(name string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.funcBreaks, name)
+}
+
+// BreakLine stops evaluations whenever they reach a statement on line
+func (d *Debugger) BreakLine(line int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lineBreaks[line] = true
+}
+
+// ClearLine removes the breakpoint of BreakLine
+func (d *Debugger) ClearLine(line int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.lineBreaks, line)
+}
+
+// SetFuncBreakpoints replaces all breakpoints of BreakFunc by ones for names
+func (d *Debugger) SetFuncBreakpoints(names []string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.funcBreaks = make(map[string]bool, len(names))
+	for _, name := range names {
+		d.funcBreaks[name] = true
+	}
+}
+
+// SetLineBreakpoints replaces all breakpoints of BreakLine by ones for lines
+func (d *Debugger) SetLineBreakpoints(lines []int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lineBreaks = make(map[int]bool, len(lines))
+	for _, line := range lines {
+		d.lineBreaks[line] = true
+	}
+}
+
+// ClearAll removes all breakpoints
+func (d *Debugger) ClearAll() {
+	d.SetFuncBreakpoints(nil)
+	d.SetLineBreakpoints(nil)
+}
+
+// Pause stops the evaluation at its next statement
+func (d *Debugger) Pause() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.pause = true
+}
+
+// FuncBreakpoints returns the names of the functions with breakpoints, sorted
+func (d *Debugger) FuncBreakpoints() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	names := make([]string, 0, len(d.funcBreaks))
+	for name := range d.funcBreaks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LineBreakpoints returns the lines with breakpoints, sorted
+func (d *Debugger) LineBreakpoints() []int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	lines := make([]int, 0, len(d.lineBreaks))
+	for line := range d.lineBreaks {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	return lines
+}
+
+// Step makes the next evaluation continue according to a from its start, e.g. StepIn stops at its first statement
+func (d *Debugger) Step(a Action) {
+	d.action = a
+	d.actionDepth = 0
+}
+
+// Active returns whether the Debugger would ever stop, i.e. whether there are breakpoints or it is stepping
+func (d *Debugger) Active() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.funcBreaks) > 0 || len(d.lineBreaks) > 0 || d.action != Continue
+}
+
+func (d *Debugger) EnterEval(c *ast.Context, e ast.Expr) {
+	if d.stopped {
+		return
+	}
+	if len(d.exprs) == 0 {
+		// A new evaluation
+		d.frames = []*Frame{{Name: TopLevel}}
+		d.funcHit = false
+	}
+	var parent ast.Expr
+	if len(d.exprs) > 0 {
+		parent = d.exprs[len(d.exprs)-1]
+	}
+	d.exprs = append(d.exprs, e)
+
+	f := d.frames[len(d.frames)-1]
+	f.Context = c
+	if _, ok := parent.(ast.Block); ok {
+		d.statement(f, e)
+	}
+}
+
+func (d *Debugger) ExitEval(c *ast.Context, e ast.Expr, v ast.Val) {
+	if d.stopped || len(d.exprs) == 0 {
+		return
+	}
+	d.exprs = d.exprs[:len(d.exprs)-1]
+	if len(d.exprs) == 0 {
+		// The evaluation is done, stepping doesn't carry over to the next one
+		d.action = Continue
+	}
+}
+
+// Panicked resets the state of the evaluation, which the panic left without the ExitEval and ReturnFunc calls
+// unwinding it, such that the next evaluation starts afresh
+func (d *Debugger) Panicked(c *ast.Context, r interface{}) {
+	d.frames = nil
+	d.exprs = nil
+	d.action = Continue
+	d.actionDepth = 0
+	d.funcHit = false
+	d.stopped = false
+}
+
+func (d *Debugger) CallFunc(c *ast.Context, kind ast.CallKind, name string, args []ast.Val) {
+	if d.stopped || kind == ast.BuiltinCall {
+		return
+	}
+	if kind == ast.LambdaCall && strings.Contains(name, "\n") {
+		// Called directly, e.g. fun(x) { ... }("a")
+		name = "<lambda>"
+	}
+	d.frames = append(d.frames, &Frame{Name: name, Kind: kind})
+	d.mu.Lock()
+	if d.funcBreaks[name] {
+		d.funcHit = true
+	}
+	d.mu.Unlock()
+}
+
+func (d *Debugger) LoopIteration(c *ast.Context, w ast.While, iteration int) {
+	if d.stopped || len(d.frames) == 0 {
+		return
+	}
+	// Every iteration evaluates the statements of the body again, hence may stop at the same line again
+	d.frames[len(d.frames)-1].line = 0
+}
+
+func (d *Debugger) ReturnFunc(c *ast.Context, kind ast.CallKind, name string, res ast.Val) {
+	if d.stopped || kind == ast.BuiltinCall || len(d.frames) <= 1 {
+		return
+	}
+	d.frames = d.frames[:len(d.frames)-1]
+}
+
+// statement is called before e is evaluated as a statement of a Block in frame f
+func (d *Debugger) statement(f *Frame, e ast.Expr) {
+	pos := ast.PosOf(e)
+	// Line breakpoints stop once per line, not at every statement on it
+	lineChanged := pos.IsValid() && pos.Line != f.line
+	f.Stmt = e
+	if pos.IsValid() {
+		f.Pos = pos
+		f.line = pos.Line
+	}
+
+	d.mu.Lock()
+	pause, lineBreak := d.pause, d.lineBreaks[pos.Line]
+	d.pause = false
+	d.mu.Unlock()
+
+	depth := len(d.frames)
+	switch {
+	case pause:
+		d.stop(PauseReason)
+	case d.funcHit:
+		d.funcHit = false
+		d.stop(FunctionBreakpointReason)
+	case d.action == StepIn,
+		d.action == StepOver && depth <= d.actionDepth,
+		d.action == StepOut && depth < d.actionDepth:
+		d.stop(StepReason)
+	case lineChanged && lineBreak:
+		d.stop(BreakpointReason)
+	}
+}
+
+func (d *Debugger) stop(reason Reason) {
+	if d.OnStop == nil {
+		return
+	}
+	frames := make([]*Frame, len(d.frames))
+	for i, f := range d.frames {
+		frames[len(frames)-1-i] = f
+	}
+
+	d.stopped = true
+	action := d.OnStop(&Stop{Reason: reason, Frames: frames})
+	d.stopped = false
+
+	d.action = action
+	d.actionDepth = len(d.frames)
+}
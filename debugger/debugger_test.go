@@ -0,0 +1,155 @@
+package debugger_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/debugger"
+)
+
+const stepSource = `fun f(x) {
+	y = x + "!";
+	y + ""
+}
+a = f("a");
+b = "b";
+a + b`
+
+// debug evaluates src using d and returns where it stopped, as "reason frame:line"
+func debug(t *testing.T, d *debugger.Debugger, src string, onStop func(s *debugger.Stop) debugger.Action) []string {
+	t.Helper()
+	e, err := stringlang.Parse([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stops := []string{}
+	d.OnStop = func(s *debugger.Stop) debugger.Action {
+		stops = append(stops, fmt.Sprintf("%s %s:%d", s.Reason, s.Frames[0].Name, s.Frames[0].Pos.Line))
+		return onStop(s)
+	}
+	c := stringlang.NewContext(nil, nil)
+	c.SetHooks(d)
+	if _, err := c.EvalContext(context.Background(), e); err != nil {
+		t.Fatal(err)
+	}
+	return stops
+}
+
+func always(a debugger.Action) func(s *debugger.Stop) debugger.Action {
+	return func(*debugger.Stop) debugger.Action { return a }
+}
+
+func TestLineBreakpoints(t *testing.T) {
+	tests := []struct {
+		src   string
+		lines []int
+		want  []string
+	}{
+		{stepSource, []int{2, 6}, []string{"breakpoint f:2", "breakpoint <top level>:6"}},
+		{"a = \"1\"; b = \"2\";\nc = \"3\"", []int{1}, []string{"breakpoint <top level>:1"}},
+		{"i = \"\";\nwhile (i != \"xxx\") {\n\ti = i + \"x\"\n};\ni", []int{3},
+			[]string{"breakpoint <top level>:3", "breakpoint <top level>:3", "breakpoint <top level>:3"}},
+		{"i = \"\"; while (i != \"xx\") { i = i + \"x\" }; i", []int{1},
+			[]string{"breakpoint <top level>:1", "breakpoint <top level>:1", "breakpoint <top level>:1"}},
+		{stepSource, []int{4}, []string{}},
+	}
+	for _, tt := range tests {
+		d := debugger.New(nil)
+		d.SetLineBreakpoints(tt.lines)
+		if got := debug(t, d, tt.src, always(debugger.Continue)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q with breakpoints at %v: got %q, want %q", tt.src, tt.lines, got, tt.want)
+		}
+	}
+}
+
+func TestLoopBreakpointVariables(t *testing.T) {
+	d := debugger.New(nil)
+	d.BreakLine(3)
+	values := []string{}
+	debug(t, d, "i = \"\";\nwhile (i != \"xxx\") {\n\ti = i + \"x\"\n};\ni", func(s *debugger.Stop) debugger.Action {
+		values = append(values, string(s.Frames[0].Context.VariableMap[ast.Var("i")]))
+		return debugger.Continue
+	})
+	if want := []string{"", "x", "xx"}; !reflect.DeepEqual(values, want) {
+		t.Errorf("got values %q, want %q", values, want)
+	}
+}
+
+func TestStepping(t *testing.T) {
+	tests := []struct {
+		name   string
+		action debugger.Action
+		want   []string
+	}{
+		{"step in", debugger.StepIn,
+			[]string{"step <top level>:5", "step f:2", "step f:3", "step <top level>:6", "step <top level>:7"}},
+		{"step over", debugger.StepOver, []string{"step <top level>:5", "step <top level>:6", "step <top level>:7"}},
+		{"step out", debugger.StepOut, []string{"step <top level>:5"}},
+	}
+	for _, tt := range tests {
+		d := debugger.New(nil)
+		d.Step(debugger.StepIn)
+		if got := debug(t, d, stepSource, always(tt.action)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	// Stepping out of a function stops at the next statement of its caller
+	d := debugger.New(nil)
+	d.BreakFunc("f")
+	got := debug(t, d, stepSource, func(s *debugger.Stop) debugger.Action {
+		if s.Frames[0].Name == "f" {
+			return debugger.StepOut
+		}
+		return debugger.Continue
+	})
+	if want := []string{"function breakpoint f:2", "step <top level>:6"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("stepping out: got %q, want %q", got, want)
+	}
+
+	// Stepping doesn't carry over to the next evaluation
+	if got := debug(t, d, `"done"`, always(debugger.StepIn)); len(got) != 0 {
+		t.Errorf("got stops %q in the next evaluation", got)
+	}
+}
+
+func TestPause(t *testing.T) {
+	d := debugger.New(nil)
+	d.Pause()
+	got := debug(t, d, stepSource, always(debugger.Continue))
+	if want := []string{"pause <top level>:5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestPanic(t *testing.T) {
+	boom := stringlang.BuiltinFunc{
+		Identifier: "boom",
+		Max:        -1,
+		Fn: func(*stringlang.CallContext, []string) (string, error) {
+			panic("boom")
+		},
+	}
+	e, err := stringlang.Parse([]byte(`fun f() { boom() } f()`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := debugger.New(nil)
+	d.BreakLine(1)
+	d.OnStop = always(debugger.StepIn)
+	c := stringlang.NewContextBuiltins(nil, boom)
+	c.SetHooks(d)
+	if _, err := c.EvalContext(context.Background(), e); err == nil {
+		t.Fatal("got no error, want the panic")
+	}
+
+	// Neither the frames nor the stepping of the evaluation which panicked carry over to the next one
+	got := debug(t, d, "a = \"a\";\nb = \"b\"", always(debugger.Continue))
+	if want := []string{"breakpoint <top level>:1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,29 @@
+package debugger
+
+import (
+	"sort"
+
+	"github.com/skius/stringlang/ast"
+)
+
+// Lines returns the lines the Debugger can stop at in prog, i.e. those of statements with a known position, sorted
+func Lines(prog ast.Program) []int {
+	lines := make(map[int]bool)
+	ast.Inspect(prog, func(e ast.Expr) bool {
+		if b, ok := e.(ast.Block); ok {
+			for _, e := range b {
+				if pos := ast.PosOf(e); pos.IsValid() {
+					lines[pos.Line] = true
+				}
+			}
+		}
+		return true
+	})
+
+	sorted := make([]int, 0, len(lines))
+	for line := range lines {
+		sorted = append(sorted, line)
+	}
+	sort.Ints(sorted)
+	return sorted
+}
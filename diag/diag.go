@@ -0,0 +1,285 @@
+// Package diag describes problems with StringLang programs, whether found by the parser, a linter or an evaluation, in
+// a single form which is printed for people or encoded as JSON or SARIF for tools.
+package diag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+// Severity is how serious a Diagnostic is
+type Severity int
+
+const (
+	Error   Severity = iota // The program can't run, or failed
+	Warning                 // The program likely doesn't do what was intended
+	Note                    // Just information
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Error:
+		return "error"
+	case Warning:
+		return "warning"
+	case Note:
+		return "note"
+	}
+	return "severity(" + fmt.Sprint(int(s)) + ")"
+}
+
+// MarshalText encodes s as its name, e.g. in JSON
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes the name of a Severity
+func (s *Severity) UnmarshalText(text []byte) error {
+	for _, sev := range []Severity{Error, Warning, Note} {
+		if sev.String() == string(text) {
+			*s = sev
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown severity %q", text)
+}
+
+// Span is a range of the source code of a file. End is exclusive, and equals Start for spans of no source code. A Span
+// with an invalid Start names just the file.
+//
+// The columns of spans made by this package count Unicode code points, unlike those of ast.Pos, which count tabs as
+// four columns.
+type Span struct {
+	File  string
+	Start ast.Pos
+	End   ast.Pos
+}
+
+func (s Span) String() string {
+	switch {
+	case s.File == "":
+		return s.Start.String()
+	case !s.Start.IsValid():
+		return s.File
+	}
+	return s.File + ":" + s.Start.String()
+}
+
+// Range returns the span from the offset start to end of src, without a file
+func Range(src []byte, start, end int) Span {
+	return Span{Start: position(src, start), End: position(src, end)}
+}
+
+// position returns the position of offset in src
+func position(src []byte, offset int) ast.Pos {
+	if offset > len(src) {
+		offset = len(src)
+	}
+	lineStart := strings.LastIndexByte(string(src[:offset]), '\n') + 1
+	return ast.Pos{
+		Offset: offset,
+		Line:   1 + strings.Count(string(src[:offset]), "\n"),
+		Column: 1 + utf8.RuneCount(src[lineStart:offset]),
+	}
+}
+
+// Related is a span of source code relevant to a Diagnostic, e.g. a definition
+type Related struct {
+	Span    Span
+	Message string
+}
+
+// Edit replaces the source code of Span by NewText
+type Edit struct {
+	Span    Span
+	NewText string
+}
+
+// Fix is a change of the source code suggested to resolve a Diagnostic
+type Fix struct {
+	Message string
+	Edits   []Edit
+}
+
+// Diagnostic is a problem with a program
+type Diagnostic struct {
+	Severity Severity
+	Code     string // Identifies the kind of problem, e.g. "syntax-error" or the name of a lint rule
+	Message  string
+	Span     Span
+	Related  []Related
+	Hint     string // How to resolve the problem, if known
+	Fix      *Fix   // Nil if there is no suggestion
+}
+
+// String returns d in the format of compilers, "file:line:column: severity: message [code]"
+func (d Diagnostic) String() string {
+	return d.Span.String() + ": " + d.Severity.String() + ": " + d.Message + " [" + d.Code + "]"
+}
+
+// Render returns d for people reading src, the source code of its span: the line of String, followed by the line of
+// the span with its columns marked and the hint
+func (d Diagnostic) Render(src []byte) string {
+	var b strings.Builder
+	b.WriteString(d.String() + "\n")
+	b.WriteString(Snippet(src, d.Span))
+	if d.Hint != "" {
+		b.WriteString("hint: " + d.Hint + "\n")
+	}
+	return b.String()
+}
+
+// Snippet returns the first line of s in src, numbered, followed by a line marking the columns of s with carets. It
+// returns the empty string for spans of just a file.
+func Snippet(src []byte, s Span) string {
+	if !s.Start.IsValid() || s.Start.Offset > len(src) {
+		return ""
+	}
+	lineStart := strings.LastIndexByte(string(src[:s.Start.Offset]), '\n') + 1
+	lineEnd := len(src)
+	if i := strings.IndexByte(string(src[lineStart:]), '\n'); i >= 0 {
+		lineEnd = lineStart + i
+	}
+	line := strings.TrimSuffix(string(src[lineStart:lineEnd]), "\r")
+	end := s.End.Offset
+	if end > lineStart+len(line) {
+		end = lineStart + len(line)
+	}
+
+	// Tabs are kept such that the carets line up with the line however wide tabs are shown
+	var marker strings.Builder
+	for _, r := range line[:s.Start.Offset-lineStart] {
+		if r == '\t' {
+			marker.WriteRune('\t')
+		} else {
+			marker.WriteRune(' ')
+		}
+	}
+	marker.WriteString("^")
+	if end > s.Start.Offset {
+		marker.WriteString(strings.Repeat("^", utf8.RuneCountInString(line[s.Start.Offset-lineStart:end-lineStart])-1))
+	}
+
+	num := fmt.Sprint(s.Start.Line)
+	gutter := strings.Repeat(" ", len(num))
+	return fmt.Sprintf(" %s | %s\n %s | %s\n", num, line, gutter, marker.String())
+}
+
+// InFile returns a copy of d whose spans without a file, including those of related spans and edits, are in file
+func (d Diagnostic) InFile(file string) Diagnostic {
+	inFile := func(s Span) Span {
+		if s.File == "" {
+			s.File = file
+		}
+		return s
+	}
+	d.Span = inFile(d.Span)
+	if d.Related != nil {
+		related := make([]Related, len(d.Related))
+		for i, r := range d.Related {
+			related[i] = Related{Span: inFile(r.Span), Message: r.Message}
+		}
+		d.Related = related
+	}
+	if d.Fix != nil {
+		fix := &Fix{Message: d.Fix.Message, Edits: make([]Edit, len(d.Fix.Edits))}
+		for i, e := range d.Fix.Edits {
+			fix.Edits[i] = Edit{Span: inFile(e.Span), NewText: e.NewText}
+		}
+		d.Fix = fix
+	}
+	return d
+}
+
+// Codes of the diagnostics FromError returns
+const (
+	CodeSyntax         = "syntax-error"
+	CodeNestingTooDeep = "nesting-too-deep"
+	CodeBuiltin        = "builtin-failed"
+	CodeTimeout        = "timeout"
+	CodeCanceled       = "canceled"
+	CodeStopped        = "stopped"
+	CodeMemoryLimit    = "memory-limit"
+	CodeRecursionLimit = "recursion-limit"
+	CodePolicy         = "policy-violation"
+	CodeReplay         = "replay-diverged"
+	CodeRuntime        = "runtime-error"
+)
+
+// Descriptions describes the codes of the diagnostics FromError returns
+var Descriptions = map[string]string{
+	CodeSyntax:         "The program doesn't parse",
+	CodeNestingTooDeep: "The program is nested too deeply to be evaluated safely",
+	CodeBuiltin:        "A built-in function failed",
+	CodeTimeout:        "The evaluation took too long",
+	CodeCanceled:       "The evaluation was canceled",
+	CodeStopped:        "The evaluation was stopped externally",
+	CodeMemoryLimit:    "The evaluation exceeded its memory limit",
+	CodeRecursionLimit: "The evaluation exceeded the maximum call depth",
+	CodePolicy:         "The program did something the policy of its host forbids",
+	CodeReplay:         "The evaluation diverged from the recording it replayed",
+	CodeRuntime:        "The evaluation failed",
+}
+
+// FromErrors returns the diagnostics describing err like FromError, one for each of stringlang.SyntaxErrors
+func FromErrors(file string, src []byte, err error) []Diagnostic {
+	if errs, ok := err.(stringlang.SyntaxErrors); ok {
+		ds := make([]Diagnostic, len(errs))
+		for i, serr := range errs {
+			ds[i] = FromError(file, src, serr)
+		}
+		return ds
+	}
+	return []Diagnostic{FromError(file, src, err)}
+}
+
+// FromError returns the diagnostic describing err, which was returned by parsing or evaluating src, the source code
+// of file. Of stringlang.SyntaxErrors, it describes the first one.
+func FromError(file string, src []byte, err error) Diagnostic {
+	if errs, ok := err.(stringlang.SyntaxErrors); ok && len(errs) > 0 {
+		err = errs[0]
+	}
+	d := Diagnostic{Severity: Error, Code: CodeRuntime, Message: err.Error(), Span: Span{File: file}}
+
+	var serr *stringlang.SyntaxError
+	var rerr *ast.RuntimeError
+	switch {
+	case errors.As(err, &rerr):
+		// Before parse errors, which built-in functions like eval fail with for the source code they parse
+		d.Code = CodeBuiltin
+		if rerr.Pos.IsValid() && rerr.Pos.Offset < len(src) {
+			// The "(" of the call
+			d.Span = Range(src, rerr.Pos.Offset, rerr.Pos.Offset+1)
+			d.Span.File = file
+		}
+	case errors.As(err, &serr):
+		d.Code = CodeSyntax
+		d.Message = serr.Message
+		d.Hint = serr.Hint
+		d.Span = Range(src, serr.Pos.Offset, serr.End)
+		d.Span.File = file
+	case errors.Is(err, stringlang.ErrNestingTooDeep):
+		d.Code = CodeNestingTooDeep
+	case errors.Is(err, context.DeadlineExceeded):
+		d.Code = CodeTimeout
+	case errors.Is(err, context.Canceled):
+		d.Code = CodeCanceled
+	case errors.Is(err, ast.ErrExternalExit):
+		d.Code = CodeStopped
+	case errors.Is(err, ast.ErrMemoryLimitExceeded):
+		d.Code = CodeMemoryLimit
+	case errors.Is(err, ast.ErrRecursionLimit):
+		d.Code = CodeRecursionLimit
+	case errors.Is(err, ast.ErrPolicyViolation):
+		d.Code = CodePolicy
+	case errors.Is(err, ast.ErrReplayDiverged):
+		d.Code = CodeReplay
+	}
+	return d
+}
@@ -0,0 +1,185 @@
+package diag_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/diag"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+const src = "x = \"a\";\ny = upper(x) +\n\t\"ü\""
+
+// diagnostics covers spans in files with special characters, spans of just a file, spans without a file, related
+// spans, hints and fixes
+var diagnostics = []diag.Diagnostic{
+	{
+		Severity: diag.Error,
+		Code:     diag.CodeSyntax,
+		Message:  "expected an expression",
+		Span:     inFile("dir/my program.stringlang", diag.Range([]byte(src), 22, 23)),
+		Hint:     "remove the \"+\"",
+	},
+	{
+		Severity: diag.Warning,
+		Code:     "unused-assign",
+		Message:  "the value assigned to y is never read",
+		Span:     inFile("main.stringlang", diag.Range([]byte(src), 9, 10)),
+		Related: []diag.Related{
+			{Span: inFile("main.stringlang", diag.Range([]byte(src), 0, 1)), Message: "x is assigned here"},
+			{Span: diag.Span{File: "other.stringlang"}, Message: "and read in another file"},
+		},
+		Fix: &diag.Fix{
+			Message: "Remove the assignment to y",
+			Edits: []diag.Edit{
+				{Span: inFile("main.stringlang", diag.Range([]byte(src), 9, 13))},
+				{Span: inFile("other.stringlang", diag.Range([]byte(src), 0, 0)), NewText: "y = \"\";\n"},
+				{Span: inFile("main.stringlang", diag.Range([]byte(src), 25, 29)), NewText: "\"u\""},
+			},
+		},
+	},
+	{
+		Severity: diag.Error,
+		Code:     diag.CodeTimeout,
+		Message:  "context deadline exceeded",
+		Span:     diag.Span{File: "main.stringlang"},
+	},
+	{
+		Severity: diag.Note,
+		Code:     "custom",
+		Message:  "no file at all",
+		Fix:      &diag.Fix{Message: "Nothing to do"},
+	},
+}
+
+func inFile(file string, s diag.Span) diag.Span {
+	s.File = file
+	return s
+}
+
+// golden compares got to the golden file name in testdata, or updates the file if the update flag is set
+func golden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := ioutil.WriteFile(path, got, 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s differs, got\n%s", name, got)
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	var b bytes.Buffer
+	if err := diag.WriteJSON(&b, diagnostics); err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "diagnostics.json", b.Bytes())
+
+	b.Reset()
+	if err := diag.WriteJSON(&b, nil); err != nil || b.String() != "[]\n" {
+		t.Errorf("encoding no diagnostics: got %q, %v", b.String(), err)
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	tool := diag.Tool{
+		Name:           "stringlang",
+		Version:        "1.0.0",
+		InformationURI: "https://github.com/skius/stringlang",
+		Rules:          map[string]string{"unused-assign": "Assignments whose value is never read"},
+	}
+	var b bytes.Buffer
+	if err := diag.WriteSARIF(&b, tool, diagnostics); err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "diagnostics.sarif", b.Bytes())
+}
+
+func TestFromError(t *testing.T) {
+	const file = "main.stringlang"
+	failing := ast.BuiltinFunc{Identifier: "fail", Fn: func(*ast.CallContext, []string) (string, error) {
+		return "", errors.New("failed")
+	}}
+	evalErr := func(src string) error {
+		e, err := stringlang.Parse([]byte(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		c := stringlang.NewContextBuiltins(nil, failing, ast.EvalBuiltin())
+		_, err = c.EvalContext(context.Background(), e)
+		return err
+	}
+	_, syntaxErr := stringlang.Parse([]byte("x = (\n\"a\""))
+
+	tests := []struct {
+		name string
+		src  string
+		err  error
+		want string
+	}{
+		{"syntax error", "x = (\n\"a\"", syntaxErr,
+			"main.stringlang:2:4: error: expected an operator, \"(\", \")\" or \"[\", found the end of the program " +
+				"[syntax-error]"},
+		{"builtin", `x = "a"; fail()`, evalErr(`x = "a"; fail()`),
+			"main.stringlang:1:14: error: fail: failed [builtin-failed]"},
+		{"eval of invalid source", `eval("(")`, evalErr(`eval("(")`),
+			"main.stringlang:1:5: error: eval: invalid source: 1:2: error: expected an expression, found the end of the " +
+				"program [builtin-failed]"},
+		{"nesting", "", stringlang.ErrNestingTooDeep,
+			"main.stringlang: error: program is nested too deeply [nesting-too-deep]"},
+		{"timeout", "", context.DeadlineExceeded, "main.stringlang: error: context deadline exceeded [timeout]"},
+		{"canceled", "", fmt.Errorf("calling f: %w", context.Canceled),
+			"main.stringlang: error: calling f: context canceled [canceled]"},
+		{"stopped", "", ast.ErrExternalExit, "main.stringlang: error: program was stopped externally [stopped]"},
+		{"memory", "", ast.ErrMemoryLimitExceeded,
+			"main.stringlang: error: program exceeded its memory limit [memory-limit]"},
+		{"recursion", "", ast.ErrRecursionLimit,
+			"main.stringlang: error: program exceeded the maximum call depth [recursion-limit]"},
+		{"policy", "", fmt.Errorf("%w: eval is disabled", ast.ErrPolicyViolation),
+			"main.stringlang: error: policy violation: eval is disabled [policy-violation]"},
+		{"replay", "", fmt.Errorf("%w: unexpected call", ast.ErrReplayDiverged),
+			"main.stringlang: error: replay diverged from recording: unexpected call [replay-diverged]"},
+		{"other", "", errors.New("something else"), "main.stringlang: error: something else [runtime-error]"},
+	}
+	for _, tt := range tests {
+		d := diag.FromError(file, []byte(tt.src), tt.err)
+		if got := d.String(); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFromErrors(t *testing.T) {
+	src := []byte("x = (;\ny = \"a\" +;\n\"b\"")
+	_, err := stringlang.ParseRecover(src)
+	ds := diag.FromErrors("main.stringlang", src, err)
+	if len(ds) != 2 {
+		t.Fatalf("got %d diagnostics, want 2: %v", len(ds), ds)
+	}
+	for i, line := range []int{1, 2} {
+		span := ds[i].Span
+		if ds[i].Code != diag.CodeSyntax || span.Start.Line != line || span.End.Offset <= span.Start.Offset {
+			t.Errorf("diagnostic %d: got %v spanning %v to %v, want a syntax error on line %d", i, ds[i], span.Start,
+				span.End, line)
+		}
+	}
+	if ds := diag.FromErrors("main.stringlang", src, context.Canceled); len(ds) != 1 || ds[0].Code != diag.CodeCanceled {
+		t.Errorf("got %v, want a single diagnostic", ds)
+	}
+}
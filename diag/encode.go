@@ -0,0 +1,259 @@
+package diag
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/skius/stringlang/ast"
+)
+
+// JSON encoding, spans of just a file have neither start nor end
+type (
+	jsonDiagnostic struct {
+		Severity Severity      `json:"severity"`
+		Code     string        `json:"code"`
+		Message  string        `json:"message"`
+		Span     jsonSpan      `json:"span"`
+		Related  []jsonRelated `json:"related,omitempty"`
+		Hint     string        `json:"hint,omitempty"`
+		Fix      *jsonFix      `json:"fix,omitempty"`
+	}
+	jsonSpan struct {
+		File  string   `json:"file,omitempty"`
+		Start *jsonPos `json:"start,omitempty"`
+		End   *jsonPos `json:"end,omitempty"`
+	}
+	jsonPos struct {
+		Offset int `json:"offset"`
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	}
+	jsonRelated struct {
+		Span    jsonSpan `json:"span"`
+		Message string   `json:"message"`
+	}
+	jsonFix struct {
+		Message string     `json:"message"`
+		Edits   []jsonEdit `json:"edits"`
+	}
+	jsonEdit struct {
+		Span    jsonSpan `json:"span"`
+		NewText string   `json:"newText"`
+	}
+)
+
+func toJSONSpan(s Span) jsonSpan {
+	res := jsonSpan{File: s.File}
+	if s.Start.IsValid() {
+		res.Start = &jsonPos{Offset: s.Start.Offset, Line: s.Start.Line, Column: s.Start.Column}
+		res.End = &jsonPos{Offset: s.End.Offset, Line: s.End.Line, Column: s.End.Column}
+	}
+	return res
+}
+
+// WriteJSON writes ds to w as a JSON array of objects with the fields severity, code, message, span, related, hint and
+// fix. Spans are objects with the fields file, start and end, which are objects with the fields offset, line and
+// column.
+func WriteJSON(w io.Writer, ds []Diagnostic) error {
+	res := make([]jsonDiagnostic, len(ds))
+	for i, d := range ds {
+		res[i] = jsonDiagnostic{
+			Severity: d.Severity,
+			Code:     d.Code,
+			Message:  d.Message,
+			Span:     toJSONSpan(d.Span),
+			Hint:     d.Hint,
+		}
+		for _, r := range d.Related {
+			res[i].Related = append(res[i].Related, jsonRelated{Span: toJSONSpan(r.Span), Message: r.Message})
+		}
+		if d.Fix != nil {
+			fix := &jsonFix{Message: d.Fix.Message, Edits: []jsonEdit{}}
+			for _, e := range d.Fix.Edits {
+				fix.Edits = append(fix.Edits, jsonEdit{Span: toJSONSpan(e.Span), NewText: e.NewText})
+			}
+			res[i].Fix = fix
+		}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+// Tool describes the tool whose diagnostics are written as SARIF
+type Tool struct {
+	Name           string
+	Version        string
+	InformationURI string
+	// Rules describes the codes of the diagnostics besides those in Descriptions, e.g. lint rules
+	Rules map[string]string
+}
+
+// SARIF 2.1.0 encoding, see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type (
+	sarifLog struct {
+		Schema  string     `json:"$schema"`
+		Version string     `json:"version"`
+		Runs    []sarifRun `json:"runs"`
+	}
+	sarifRun struct {
+		Tool       sarifTool     `json:"tool"`
+		ColumnKind string        `json:"columnKind"`
+		Results    []sarifResult `json:"results"`
+	}
+	sarifTool struct {
+		Driver sarifDriver `json:"driver"`
+	}
+	sarifDriver struct {
+		Name           string      `json:"name"`
+		Version        string      `json:"version,omitempty"`
+		InformationURI string      `json:"informationUri,omitempty"`
+		Rules          []sarifRule `json:"rules"`
+	}
+	sarifRule struct {
+		ID               string        `json:"id"`
+		ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	}
+	sarifMessage struct {
+		Text string `json:"text"`
+	}
+	sarifResult struct {
+		RuleID           string          `json:"ruleId"`
+		RuleIndex        int             `json:"ruleIndex"`
+		Level            string          `json:"level"`
+		Message          sarifMessage    `json:"message"`
+		Locations        []sarifLocation `json:"locations"`
+		RelatedLocations []sarifLocation `json:"relatedLocations,omitempty"`
+		Fixes            []sarifFix      `json:"fixes,omitempty"`
+	}
+	sarifLocation struct {
+		ID               *int                  `json:"id,omitempty"`
+		PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+		Message          *sarifMessage         `json:"message,omitempty"`
+	}
+	sarifPhysicalLocation struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Region           *sarifRegion          `json:"region,omitempty"`
+	}
+	sarifArtifactLocation struct {
+		URI string `json:"uri"`
+	}
+	sarifRegion struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+		EndLine     int `json:"endLine"`
+		EndColumn   int `json:"endColumn"`
+	}
+	sarifFix struct {
+		Description     sarifMessage          `json:"description"`
+		ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+	}
+	sarifArtifactChange struct {
+		ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+		Replacements     []sarifReplacement    `json:"replacements"`
+	}
+	sarifReplacement struct {
+		DeletedRegion   sarifRegion   `json:"deletedRegion"`
+		InsertedContent *sarifMessage `json:"insertedContent,omitempty"`
+	}
+)
+
+func artifact(file string) sarifArtifactLocation {
+	return sarifArtifactLocation{URI: (&url.URL{Path: filepath.ToSlash(file)}).String()}
+}
+
+func region(s Span) sarifRegion {
+	return sarifRegion{StartLine: s.Start.Line, StartColumn: s.Start.Column, EndLine: s.End.Line, EndColumn: s.End.Column}
+}
+
+func physicalLocation(s Span) sarifPhysicalLocation {
+	loc := sarifPhysicalLocation{ArtifactLocation: artifact(s.File)}
+	if s.Start.IsValid() {
+		r := region(s)
+		loc.Region = &r
+	}
+	return loc
+}
+
+// WriteSARIF writes ds to w as a SARIF 2.1.0 log of a single run of tool. Every code of ds is a rule of tool, described
+// by tool.Rules or Descriptions.
+func WriteSARIF(w io.Writer, tool Tool, ds []Diagnostic) error {
+	codes := make(ast.Set)
+	for _, d := range ds {
+		codes.Add(d.Code)
+	}
+	driver := sarifDriver{Name: tool.Name, Version: tool.Version, InformationURI: tool.InformationURI, Rules: []sarifRule{}}
+	ruleIndex := make(map[string]int, len(codes))
+	for code := range codes {
+		driver.Rules = append(driver.Rules, sarifRule{ID: code})
+	}
+	sort.Slice(driver.Rules, func(i, j int) bool { return driver.Rules[i].ID < driver.Rules[j].ID })
+	for i := range driver.Rules {
+		id := driver.Rules[i].ID
+		ruleIndex[id] = i
+		if desc, ok := tool.Rules[id]; ok {
+			driver.Rules[i].ShortDescription = &sarifMessage{Text: desc}
+		} else if desc, ok := Descriptions[id]; ok {
+			driver.Rules[i].ShortDescription = &sarifMessage{Text: desc}
+		}
+	}
+
+	results := make([]sarifResult, len(ds))
+	for i, d := range ds {
+		msg := d.Message
+		if d.Hint != "" {
+			msg += "\nhint: " + d.Hint
+		}
+		res := sarifResult{
+			RuleID:    d.Code,
+			RuleIndex: ruleIndex[d.Code],
+			Level:     d.Severity.String(),
+			Message:   sarifMessage{Text: msg},
+			Locations: []sarifLocation{},
+		}
+		// SARIF has no locations without an artifact
+		if d.Span.File != "" || d.Span.Start.IsValid() {
+			res.Locations = append(res.Locations, sarifLocation{PhysicalLocation: physicalLocation(d.Span)})
+		}
+		for j, r := range d.Related {
+			id := j
+			res.RelatedLocations = append(res.RelatedLocations, sarifLocation{
+				ID:               &id,
+				PhysicalLocation: physicalLocation(r.Span),
+				Message:          &sarifMessage{Text: r.Message},
+			})
+		}
+		if d.Fix != nil {
+			// Edits grouped by file, in the order of their first edit
+			fix := sarifFix{Description: sarifMessage{Text: d.Fix.Message}, ArtifactChanges: []sarifArtifactChange{}}
+			changes := make(map[string]int)
+			for _, e := range d.Fix.Edits {
+				idx, ok := changes[e.Span.File]
+				if !ok {
+					idx = len(fix.ArtifactChanges)
+					changes[e.Span.File] = idx
+					fix.ArtifactChanges = append(fix.ArtifactChanges, sarifArtifactChange{ArtifactLocation: artifact(e.Span.File)})
+				}
+				repl := sarifReplacement{DeletedRegion: region(e.Span)}
+				if e.NewText != "" {
+					repl.InsertedContent = &sarifMessage{Text: e.NewText}
+				}
+				fix.ArtifactChanges[idx].Replacements = append(fix.ArtifactChanges[idx].Replacements, repl)
+			}
+			res.Fixes = []sarifFix{fix}
+		}
+		results[i] = res
+	}
+
+	log := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, ColumnKind: "unicodeCodePoints", Results: results}},
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
@@ -0,0 +1,75 @@
+// Package format formats StringLang source code. Unlike printing a parsed program, formatting keeps comments, the
+// literals as written and blank lines separating statements.
+package format
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+// DefaultIndent is the indentation of one level of nesting unless Options specify another one
+const DefaultIndent = "    "
+
+// ErrChangedProgram is returned if the formatted source doesn't parse to the same program as the original, which would
+// be a bug of the formatter
+var ErrChangedProgram = errors.New("formatting changed the program")
+
+// Options configure the formatting
+type Options struct {
+	// Indent is the indentation of one level of nesting, e.g. "\t", DefaultIndent if empty
+	Indent string
+}
+
+// Source formats src. Formatting is idempotent, and the formatted source parses to a program equal to the one of src,
+// i.e. one differing at most in the positions of its expressions.
+//
+// Statements with syntax errors are kept as written. If src has syntax errors, Source returns the formatted source
+// along with the stringlang.SyntaxErrors of src, or just the errors if src couldn't be parsed at all.
+func Source(src []byte, opts Options) ([]byte, error) {
+	orig, origErr := stringlang.ParseRecover(src)
+	if orig == nil {
+		return nil, origErr
+	}
+	prog, err := parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("format: %v", err)
+	}
+
+	p := &printer{indent: opts.Indent}
+	if p.indent == "" {
+		p.indent = DefaultIndent
+	}
+	p.program(prog)
+	res := p.out.Bytes()
+
+	formatted, err := stringlang.ParseRecover(res)
+	if formatted == nil || !sameProgram(orig.(ast.Program), formatted.(ast.Program)) ||
+		countErrors(origErr) != countErrors(err) {
+		return nil, ErrChangedProgram
+	}
+	return res, origErr
+}
+
+// countErrors returns the number of syntax errors of err, which ParseRecover returned
+func countErrors(err error) int {
+	if errs, ok := err.(stringlang.SyntaxErrors); ok {
+		return len(errs)
+	}
+	return 0
+}
+
+// sameProgram returns whether a and b are equal programs including their doc comments, ignoring positions
+func sameProgram(a, b ast.Program) bool {
+	if !ast.Equal(a, b) {
+		return false
+	}
+	for i := range a.Funcs {
+		if a.Funcs[i].Doc != b.Funcs[i].Doc {
+			return false
+		}
+	}
+	return true
+}
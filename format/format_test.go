@@ -0,0 +1,91 @@
+package format_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/format"
+)
+
+// commentSources are edge cases of keeping comments
+var commentSources = map[string]string{
+	"comment at the end": `x = "a" /* trailing */`,
+	"block comment inside an expression": `x = "a" + /* inline */ "b";
+x`,
+	"comments only around code": `/* before */
+
+x = "a"
+
+/* after */`,
+	"doc comment": `/* Doc
+ * of f
+ */
+fun f() { "f" }
+f()`,
+	"comment between functions": `fun f() { "f" }
+/* between */
+fun g() { "g" }
+f() + g()`,
+	"comment in empty block": `if ("a" == "b") { /* nothing */ "" } else { "c" }`,
+	"comment in arguments": `f(/* first */ "a", /* second */
+	"b")`,
+	"comment in lambda": `l = fun(x) {
+	/* returns x */
+	x
+};
+l("a")`,
+	"comment markers in strings": `"/* not a comment */" + "*/"`,
+	"blank lines between statements": `x = "a";
+
+
+y = "b";
+x + y`,
+}
+
+func TestSource(t *testing.T) {
+	srcs := make(map[string][]byte)
+	for name, src := range commentSources {
+		srcs[name] = []byte(src)
+	}
+	files, err := filepath.Glob(filepath.Join("..", "stringlang_programs", "*.stringlang"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no example programs: %v", err)
+	}
+	for _, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		srcs[filepath.Base(file)] = src
+	}
+
+	for name, src := range srcs {
+		for _, opts := range []format.Options{{}, {Indent: "\t"}} {
+			formatted, err := format.Source(src, opts)
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+				continue
+			}
+			if strings.Count(string(formatted), "/*") != strings.Count(string(src), "/*") {
+				t.Errorf("%s: formatting lost comments, got\n%s", name, formatted)
+			}
+			again, err := format.Source(formatted, opts)
+			if err != nil || string(again) != string(formatted) {
+				t.Errorf("%s: formatting isn't idempotent, got\n%s\nthen\n%s (%v)", name, formatted, again, err)
+			}
+
+			orig, err := stringlang.Parse(src)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			got, err := stringlang.Parse(formatted)
+			if err != nil || !ast.Equal(orig, got) {
+				t.Errorf("%s: formatting changed the program to\n%s (%v)", name, formatted, err)
+			}
+		}
+	}
+}
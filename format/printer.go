@@ -0,0 +1,265 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Whitespace the printer writes before the next text, larger values include smaller ones
+const (
+	wsNone = iota
+	wsSpace
+	wsNewline
+	wsBlank // An empty line
+)
+
+// printer prints a concrete syntax tree. Constructs request the whitespace they want between their tokens, and the
+// printer writes the largest requested whitespace once the next text follows.
+type printer struct {
+	indent string
+	level  int
+	out    bytes.Buffer
+	ws     int
+	last   string // The text written last
+}
+
+func (p *printer) request(ws int) {
+	if ws > p.ws {
+		p.ws = ws
+	}
+}
+
+func (p *printer) write(text string) {
+	if p.out.Len() > 0 {
+		switch p.ws {
+		case wsSpace:
+			if !isCloser(text) && p.last != "(" && p.last != "[" {
+				p.out.WriteByte(' ')
+			}
+		case wsNewline, wsBlank:
+			p.out.WriteByte('\n')
+			if p.ws == wsBlank {
+				p.out.WriteByte('\n')
+			}
+			p.out.WriteString(strings.Repeat(p.indent, p.level))
+		}
+	}
+	p.ws = wsNone
+	p.out.WriteString(text)
+	p.last = text
+}
+
+func isCloser(text string) bool {
+	return text == ")" || text == "]" || text == "," || text == ";"
+}
+
+// token writes t and the comments attached to it. Comments within a line are separated from their surroundings like
+// words, hence it doesn't matter whether they trail the token before them or lead the one after them.
+func (p *printer) token(t *tok) {
+	for _, c := range t.leading {
+		p.request(wsSpace)
+		p.comment(c)
+		p.request(wsSpace)
+	}
+	t.leading = nil
+	p.write(t.lit)
+	for _, c := range t.trailing {
+		p.request(wsSpace)
+		p.comment(c)
+		p.request(wsSpace)
+	}
+}
+
+// comment writes c, indenting the following lines of comments starting a line like their first
+func (p *printer) comment(c *comment) {
+	text := c.text
+	if c.ownLine && p.ws >= wsNewline && strings.Contains(text, "\n") {
+		indent := strings.Repeat(p.indent, p.level)
+		lines := strings.Split(text, "\n")
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) != "" && strings.HasPrefix(lines[i], c.indent) {
+				lines[i] = indent + lines[i][len(c.indent):]
+			}
+		}
+		text = strings.Join(lines, "\n")
+	}
+	p.write(text)
+}
+
+// line starts a new line for the statement, declaration or closing brace starting with t, preceded by the comments
+// leading t on lines of their own. Blank lines of the source are kept, except for the first line of a block. ws is the
+// minimum whitespace before the first line.
+func (p *printer) line(t *tok, ws int, firstInBlock bool) {
+	for i, c := range t.leading {
+		switch {
+		case !c.ownLine:
+			p.request(wsSpace)
+		case c.blankBefore && !(firstInBlock && i == 0):
+			p.request(wsBlank)
+		default:
+			p.request(wsNewline)
+		}
+		p.request(ws)
+		ws = wsNone
+		p.comment(c)
+	}
+	switch {
+	case len(t.leading) > 0 && !t.newlineBefore:
+		p.request(wsSpace)
+	case t.blankBefore && !(firstInBlock && len(t.leading) == 0):
+		p.request(wsBlank)
+	default:
+		p.request(wsNewline)
+	}
+	p.request(ws)
+	t.leading = nil
+}
+
+func (p *printer) program(prog *program) {
+	for i, f := range prog.funcs {
+		ws := wsBlank
+		if i == 0 {
+			ws = wsNewline
+		}
+		p.line(f.fun, ws, i == 0)
+		p.function(f)
+	}
+	for i, stmt := range prog.code.stmts {
+		ws := wsNewline
+		if i == 0 && len(prog.funcs) > 0 {
+			ws = wsBlank
+		}
+		if !isEmpty(stmt) {
+			p.line(first(stmt), ws, i == 0 && len(prog.funcs) == 0)
+			p.expr(stmt)
+		}
+		if i < len(prog.code.semis) {
+			p.token(prog.code.semis[i])
+		}
+	}
+	// Comments at the end
+	if len(prog.eof.leading) > 0 {
+		p.line(prog.eof, wsNewline, false)
+	}
+	if p.out.Len() > 0 {
+		p.out.WriteByte('\n')
+	}
+}
+
+func (p *printer) expr(n node) {
+	switch n := n.(type) {
+	case *leaf:
+		p.token(n.t)
+	case *arg:
+		p.token(n.percent)
+		p.token(n.num)
+	case *binary:
+		p.expr(n.lhs)
+		p.request(wsSpace)
+		p.token(n.op)
+		p.request(wsSpace)
+		p.expr(n.rhs)
+	case *assign:
+		p.token(n.v)
+		p.request(wsSpace)
+		p.token(n.eq)
+		p.request(wsSpace)
+		p.expr(n.e)
+	case *paren:
+		p.token(n.open)
+		p.expr(n.e)
+		p.token(n.close)
+	case *call:
+		p.expr(n.fn)
+		p.token(n.open)
+		for i, a := range n.args {
+			p.expr(a)
+			if i < len(n.commas) {
+				p.token(n.commas[i])
+				p.request(wsSpace)
+			}
+		}
+		p.token(n.close)
+	case *index:
+		p.expr(n.src)
+		p.token(n.open)
+		p.expr(n.i)
+		p.token(n.close)
+	case *function:
+		p.function(n)
+	case *ifElse:
+		p.token(n.ifTok)
+		p.request(wsSpace)
+		p.condition(n.open, n.cond, n.close)
+		p.block(n.then)
+		p.request(wsSpace)
+		p.token(n.elseTok)
+		p.request(wsSpace)
+		if b, ok := n.els.(*block); ok {
+			p.block(b)
+		} else {
+			p.expr(n.els)
+		}
+	case *while:
+		p.token(n.whileTok)
+		p.request(wsSpace)
+		p.condition(n.open, n.cond, n.close)
+		p.block(n.body)
+	case *bad:
+		// As written, followed by the comments trailing it
+		t := *n.first
+		t.lit = n.text
+		t.trailing = n.last.trailing
+		p.token(&t)
+	}
+}
+
+// isEmpty returns whether n is a statement without tokens, which is printed as nothing
+func isEmpty(n node) bool {
+	b, ok := n.(*bad)
+	return ok && b.first == nil
+}
+
+func (p *printer) condition(open *tok, cond node, close *tok) {
+	p.token(open)
+	p.expr(cond)
+	p.token(close)
+	p.request(wsSpace)
+}
+
+func (p *printer) function(f *function) {
+	p.token(f.fun)
+	if f.name != nil {
+		p.request(wsSpace)
+		p.token(f.name)
+	}
+	p.token(f.open)
+	for i, param := range f.params {
+		p.token(param)
+		if i < len(f.commas) {
+			p.token(f.commas[i])
+			p.request(wsSpace)
+		}
+	}
+	p.token(f.close)
+	p.request(wsSpace)
+	p.block(f.body)
+}
+
+func (p *printer) block(b *block) {
+	p.token(b.lbrace)
+	p.level++
+	for i, stmt := range b.stmts {
+		if !isEmpty(stmt) {
+			p.line(first(stmt), wsNewline, i == 0)
+			p.expr(stmt)
+		}
+		if i < len(b.semis) {
+			p.token(b.semis[i])
+		}
+	}
+	b.rbrace.blankBefore = false
+	p.line(b.rbrace, wsNewline, false)
+	p.level--
+	p.token(b.rbrace)
+}
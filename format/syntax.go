@@ -0,0 +1,487 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skius/stringlang/internal/syntax"
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+// The concrete syntax tree keeps every token of the source, and every comment is attached to a token. A comment on the
+// same line as the token before it trails that token, all others lead the token after them.
+
+type tok struct {
+	typ           token.Type
+	lit           string
+	pos           token.Pos
+	leading       []*comment
+	trailing      []*comment
+	newlineBefore bool // Whether a newline separates the token from the comment or token before it
+	blankBefore   bool // Whether a blank line separates the token from the comment or token before it
+}
+
+type comment struct {
+	text        string
+	ownLine     bool   // Whether the comment starts a line
+	blankBefore bool   // Whether a blank line separates the comment from the comment or token before it
+	indent      string // The whitespace before the comment on its line, if it starts one
+}
+
+type node interface{}
+
+type (
+	leaf struct{ t *tok } // Variable or string literal
+	arg  struct{ percent, num *tok }
+
+	binary struct {
+		lhs node
+		op  *tok
+		rhs node
+	}
+	assign struct {
+		v, eq *tok
+		e     node
+	}
+	paren struct {
+		open  *tok
+		e     node
+		close *tok
+	}
+	call struct {
+		fn     node
+		open   *tok
+		args   []node
+		commas []*tok
+		close  *tok
+	}
+	index struct {
+		src   node
+		open  *tok
+		i     node // *leaf holding an int_lit for constant indices
+		close *tok
+	}
+	// function is a function declaration or a lambda, which has no name
+	function struct {
+		fun, name, open *tok
+		params, commas  []*tok
+		close           *tok
+		body            *block
+	}
+	ifElse struct {
+		ifTok, open *tok
+		cond        node
+		close       *tok
+		then        *block
+		elseTok     *tok
+		els         node // *block or *ifElse
+	}
+	while struct {
+		whileTok, open *tok
+		cond           node
+		close          *tok
+		body           *block
+	}
+	// bad is a statement that doesn't parse, which is kept as written. Empty statements, e.g. the one following a
+	// trailing ";", have no tokens.
+	bad struct {
+		first, last *tok
+		text        string // The source code from first to last
+	}
+	// block is the code of a program, which has no braces, or the code between braces
+	block struct {
+		lbrace *tok
+		stmts  []node
+		semis  []*tok
+		rbrace *tok
+	}
+	program struct {
+		funcs []*function
+		code  *block
+		eof   *tok
+	}
+)
+
+// first returns the first token of n
+func first(n node) *tok {
+	switch n := n.(type) {
+	case *leaf:
+		return n.t
+	case *arg:
+		return n.percent
+	case *binary:
+		return first(n.lhs)
+	case *assign:
+		return n.v
+	case *paren:
+		return n.open
+	case *call:
+		return first(n.fn)
+	case *index:
+		return first(n.src)
+	case *function:
+		return n.fun
+	case *ifElse:
+		return n.ifTok
+	case *while:
+		return n.whileTok
+	case *bad:
+		return n.first
+	}
+	panic(fmt.Sprintf("format: unexpected node %T", n))
+}
+
+// scan returns the tokens of src ending with the EOF token, with all comments attached
+func scan(src []byte) []*tok {
+	var toks []*tok
+	var prev *tok
+	end := 0 // End of the previous token
+	l := syntax.NewScanner(src)
+	for {
+		t := l.Scan()
+		next := &tok{typ: t.Type, lit: string(t.Lit), pos: t.Pos}
+		attachComments(string(src[end:t.Pos.Offset]), prev, next)
+		toks = append(toks, next)
+		if t.Type == token.EOF {
+			return toks
+		}
+		prev, end = next, t.Pos.Offset+len(t.Lit)
+	}
+}
+
+// attachComments attaches the comments of gap, the text between prev and next, and records the line breaks in it. prev
+// is nil at the start of the source.
+func attachComments(gap string, prev, next *tok) {
+	newlines := 0
+	indent := ""
+	lineStart := prev == nil
+	leading := lineStart
+	for {
+		start := strings.Index(gap, "/*")
+		if start < 0 {
+			break
+		}
+		ws := gap[:start]
+		newlines += strings.Count(ws, "\n")
+		if i := strings.LastIndex(ws, "\n"); i >= 0 {
+			indent = ws[i+1:]
+		} else {
+			indent += ws
+		}
+		stop := strings.Index(gap[start+2:], "*/")
+		if stop < 0 {
+			break
+		}
+		stop += start + 4
+		c := &comment{text: gap[start:stop], ownLine: newlines > 0 || lineStart, blankBefore: newlines > 1}
+		if c.ownLine {
+			c.indent = indent
+		}
+		// Once a comment leads next, all later ones do as well
+		leading = leading || c.ownLine
+		if leading {
+			next.leading = append(next.leading, c)
+		} else {
+			prev.trailing = append(prev.trailing, c)
+		}
+		gap = gap[stop:]
+		newlines, indent, lineStart = 0, "", false
+	}
+	newlines += strings.Count(gap, "\n")
+	next.newlineBefore = newlines > 0 || lineStart
+	next.blankBefore = newlines > 1
+}
+
+var (
+	tokFun    = token.TokMap.Type("fun")
+	tokID     = token.TokMap.Type("id")
+	tokLParen = token.TokMap.Type("(")
+	tokRParen = token.TokMap.Type(")")
+	tokLBrace = token.TokMap.Type("{")
+	tokRBrace = token.TokMap.Type("}")
+	tokComma  = token.TokMap.Type(",")
+	tokSemi   = token.TokMap.Type(";")
+	tokAssign = token.TokMap.Type("=")
+	tokString = token.TokMap.Type("string_lit")
+	tokLBrack = token.TokMap.Type("[")
+	tokRBrack = token.TokMap.Type("]")
+	tokInt    = token.TokMap.Type("int_lit")
+	tokArg    = token.TokMap.Type("%")
+	tokIf     = token.TokMap.Type("if")
+	tokElse   = token.TokMap.Type("else")
+	tokWhile  = token.TokMap.Type("while")
+
+	// Binary operators by precedence, the loosest first
+	binaryOps = [][]token.Type{
+		{token.TokMap.Type("||")},
+		{token.TokMap.Type("&&")},
+		{token.TokMap.Type("!=")},
+		{token.TokMap.Type("==")},
+		{token.TokMap.Type("+")},
+	}
+)
+
+// parser builds the concrete syntax tree following the grammar in lang.bnf. Like the parser of stringlang.ParseRecover,
+// it recovers from syntax errors at the end of the statement containing them.
+type parser struct {
+	src  []byte
+	toks []*tok
+	i    int
+}
+
+func parse(src []byte) (*program, error) {
+	p := &parser{src: src, toks: scan(src)}
+	prog := &program{code: &block{}}
+	for p.is(0, tokFun) && p.is(1, tokID) {
+		start := p.i
+		f, err := p.function()
+		if err != nil {
+			// Parsed as a statement that doesn't parse
+			p.i = start
+			break
+		}
+		prog.funcs = append(prog.funcs, f)
+	}
+	if !p.is(0, token.EOF) {
+		p.stmts(prog.code, true)
+	}
+	eof, err := p.expect(token.EOF)
+	prog.eof = eof
+	return prog, err
+}
+
+// is returns whether the k-th next token has type typ
+func (p *parser) is(k int, typ token.Type) bool {
+	return p.i+k < len(p.toks) && p.toks[p.i+k].typ == typ
+}
+
+func (p *parser) next() *tok {
+	t := p.toks[p.i]
+	if p.i < len(p.toks)-1 {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) expect(typ token.Type) (*tok, error) {
+	if !p.is(0, typ) {
+		t := p.toks[p.i]
+		return nil, fmt.Errorf("%d:%d: expected %s, got %q", t.pos.Line, t.pos.Column, token.TokMap.Id(typ), t.lit)
+	}
+	return p.next(), nil
+}
+
+// function parses a function declaration or a lambda
+func (p *parser) function() (f *function, err error) {
+	f = new(function)
+	f.fun = p.next()
+	if p.is(0, tokID) {
+		f.name = p.next()
+	}
+	if f.open, err = p.expect(tokLParen); err != nil {
+		return nil, err
+	}
+	if p.is(0, tokID) {
+		f.params = append(f.params, p.next())
+		for p.is(0, tokComma) {
+			f.commas = append(f.commas, p.next())
+			param, err := p.expect(tokID)
+			if err != nil {
+				return nil, err
+			}
+			f.params = append(f.params, param)
+		}
+	}
+	if f.close, err = p.expect(tokRParen); err != nil {
+		return nil, err
+	}
+	f.body, err = p.block()
+	return f, err
+}
+
+// block parses statements between braces
+func (p *parser) block() (b *block, err error) {
+	b = new(block)
+	if b.lbrace, err = p.expect(tokLBrace); err != nil {
+		return nil, err
+	}
+	p.stmts(b, false)
+	b.rbrace, err = p.expect(tokRBrace)
+	return b, err
+}
+
+// stmts parses the statements of b, which is the code of the program if top is set. Statements that don't parse
+// become bad ones.
+func (p *parser) stmts(b *block, top bool) {
+	for {
+		start := p.i
+		e, err := p.expr()
+		if err != nil || !p.atStmtEnd(top) {
+			p.i = start
+			e = p.bad(top)
+		}
+		b.stmts = append(b.stmts, e)
+		if !p.is(0, tokSemi) {
+			return
+		}
+		b.semis = append(b.semis, p.next())
+	}
+}
+
+// atStmtEnd returns whether the next token ends a statement, i.e. is a ";", the end of the program, or a "}" unless
+// the statement is at the top level
+func (p *parser) atStmtEnd(top bool) bool {
+	return p.is(0, tokSemi) || p.is(0, token.EOF) || !top && p.is(0, tokRBrace)
+}
+
+// bad skips the tokens of a statement that doesn't parse, the "}" of blocks within it don't end it
+func (p *parser) bad(top bool) *bad {
+	start := p.i
+	depth := 0
+	for !p.is(0, token.EOF) && !(depth == 0 && p.atStmtEnd(top)) {
+		switch {
+		case p.is(0, tokLBrace):
+			depth++
+		case p.is(0, tokRBrace) && depth > 0:
+			depth--
+		}
+		p.next()
+	}
+	if p.i == start {
+		return &bad{}
+	}
+	first, last := p.toks[start], p.toks[p.i-1]
+	return &bad{first: first, last: last, text: string(p.src[first.pos.Offset : last.pos.Offset+len(last.lit)])}
+}
+
+func (p *parser) expr() (node, error) {
+	if p.is(0, tokID) && p.is(1, tokAssign) {
+		a := &assign{v: p.next(), eq: p.next()}
+		e, err := p.expr()
+		a.e = e
+		return a, err
+	}
+	return p.binary(0)
+}
+
+// binary parses the left-associative operators of binaryOps[level:]
+func (p *parser) binary(level int) (node, error) {
+	if level == len(binaryOps) {
+		return p.leaf()
+	}
+	lhs, err := p.binary(level + 1)
+	for err == nil && p.isAny(binaryOps[level]) {
+		op := p.next()
+		var rhs node
+		rhs, err = p.binary(level + 1)
+		lhs = &binary{lhs: lhs, op: op, rhs: rhs}
+	}
+	return lhs, err
+}
+
+func (p *parser) isAny(types []token.Type) bool {
+	for _, typ := range types {
+		if p.is(0, typ) {
+			return true
+		}
+	}
+	return false
+}
+
+// leaf parses an operand followed by any number of calls and indices
+func (p *parser) leaf() (n node, err error) {
+	switch {
+	case p.is(0, tokIf):
+		n, err = p.ifElse()
+	case p.is(0, tokWhile):
+		w := &while{whileTok: p.next()}
+		if w.open, err = p.expect(tokLParen); err != nil {
+			return nil, err
+		}
+		if w.cond, err = p.expr(); err != nil {
+			return nil, err
+		}
+		if w.close, err = p.expect(tokRParen); err != nil {
+			return nil, err
+		}
+		w.body, err = p.block()
+		n = w
+	case p.is(0, tokString), p.is(0, tokID):
+		n = &leaf{p.next()}
+	case p.is(0, tokArg):
+		a := &arg{percent: p.next()}
+		a.num, err = p.expect(tokInt)
+		n = a
+	case p.is(0, tokLParen):
+		par := &paren{open: p.next()}
+		if par.e, err = p.expr(); err != nil {
+			return nil, err
+		}
+		par.close, err = p.expect(tokRParen)
+		n = par
+	case p.is(0, tokFun):
+		n, err = p.function()
+	default:
+		t := p.toks[p.i]
+		return nil, fmt.Errorf("%d:%d: unexpected %q", t.pos.Line, t.pos.Column, t.lit)
+	}
+
+	for err == nil {
+		switch {
+		case p.is(0, tokLParen):
+			c := &call{fn: n, open: p.next()}
+			if !p.is(0, tokRParen) {
+				for {
+					var a node
+					if a, err = p.expr(); err != nil {
+						return nil, err
+					}
+					c.args = append(c.args, a)
+					if !p.is(0, tokComma) {
+						break
+					}
+					c.commas = append(c.commas, p.next())
+				}
+			}
+			c.close, err = p.expect(tokRParen)
+			n = c
+		case p.is(0, tokLBrack):
+			idx := &index{src: n, open: p.next()}
+			if p.is(0, tokInt) && p.is(1, tokRBrack) {
+				idx.i = &leaf{p.next()}
+			} else if idx.i, err = p.expr(); err != nil {
+				return nil, err
+			}
+			idx.close, err = p.expect(tokRBrack)
+			n = idx
+		default:
+			return n, nil
+		}
+	}
+	return nil, err
+}
+
+func (p *parser) ifElse() (n *ifElse, err error) {
+	n = &ifElse{ifTok: p.next()}
+	if n.open, err = p.expect(tokLParen); err != nil {
+		return nil, err
+	}
+	if n.cond, err = p.expr(); err != nil {
+		return nil, err
+	}
+	if n.close, err = p.expect(tokRParen); err != nil {
+		return nil, err
+	}
+	if n.then, err = p.block(); err != nil {
+		return nil, err
+	}
+	if n.elseTok, err = p.expect(tokElse); err != nil {
+		return nil, err
+	}
+	if p.is(0, tokIf) {
+		n.els, err = p.ifElse()
+	} else {
+		n.els, err = p.block()
+	}
+	return n, err
+}
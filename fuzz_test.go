@@ -0,0 +1,128 @@
+//go:build go1.18
+// +build go1.18
+
+package stringlang_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/optimizer"
+)
+
+// addPrograms seeds the corpus of f with the example programs
+func addPrograms(f *testing.F) {
+	paths, err := filepath.Glob("stringlang_programs/*.stringlang")
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, path := range paths {
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(src)
+	}
+	f.Add([]byte(concurrentSource))
+}
+
+func FuzzParse(f *testing.F) {
+	addPrograms(f)
+	f.Fuzz(func(t *testing.T, src []byte) {
+		stringlang.ParseRecover(src)
+		e, err := stringlang.Parse(src)
+		if err != nil {
+			return
+		}
+		printed := e.String()
+		again, err := stringlang.Parse([]byte(printed))
+		if err != nil {
+			t.Fatalf("parsing the printed program %q: %v", printed, err)
+		}
+		if !ast.Equal(again, e) {
+			t.Fatalf("parsing the printed program %q: got %#v, want %#v", printed, again, e)
+		}
+	})
+}
+
+func FuzzEval(f *testing.F) {
+	addPrograms(f)
+	seed := int64(1)
+	f.Fuzz(func(t *testing.T, src []byte) {
+		compiled, err := stringlang.Compile(src)
+		if err != nil {
+			return
+		}
+		goCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = compiled.Run(goCtx, stringlang.RunOptions{
+			Args:     []string{"5", "abc"},
+			Builtins: []stringlang.Builtin{lengthBuiltin, ast.EvalBuiltin(), ast.EvalIsolatedBuiltin()},
+			Limits:   ast.Limits{MaxMemory: 1024 * 1024, MaxCallDepth: 100},
+			Seed:     &seed,
+		})
+		// Evaluation turns panics into errors, hence only the limits, the timeout and runtime errors (e.g. of eval
+		// with invalid source or calls with the wrong number of arguments) may abort it
+		var runtimeErr *ast.RuntimeError
+		switch {
+		case err == nil, errors.As(err, &runtimeErr), errors.Is(err, ast.ErrMemoryLimitExceeded),
+			errors.Is(err, ast.ErrRecursionLimit), errors.Is(err, context.DeadlineExceeded),
+			errors.Is(err, context.Canceled):
+		default:
+			t.Fatalf("evaluating %q: %v", src, err)
+		}
+	})
+}
+
+var lengthBuiltin = stringlang.BuiltinFunc{
+	Identifier: "length",
+	Min:        1,
+	Max:        1,
+	IsPure:     true,
+	Fn: func(_ *stringlang.CallContext, args []string) (string, error) {
+		return strconv.Itoa(len(args[0])), nil
+	},
+}
+
+// evalLimited evaluates prog with fixed arguments and built-in functions, it fails if evaluation exceeds the limits
+func evalLimited(prog ast.Program) (string, error) {
+	ctx := stringlang.NewContextBuiltins([]string{"5", "abc"}, lengthBuiltin)
+	ctx.SetLimits(ast.Limits{MaxMemory: 1024 * 1024, MaxCallDepth: 100})
+	goCtx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	return stringlang.EvalContext(goCtx, ctx, prog)
+}
+
+func FuzzNormalize(f *testing.F) {
+	addPrograms(f)
+	f.Add([]byte(`a = "x"; if (a == "x" && (a = "y") == "y") { a } else { "no" } + a`))
+	f.Add([]byte(`i = ""; while (length(i) != %0 || i == "") { i = i + "x" }; i[%1]`))
+
+	f.Fuzz(func(t *testing.T, src []byte) {
+		e, err := stringlang.Parse(src)
+		if err != nil {
+			return
+		}
+		prog := e.(ast.Program)
+		want, err := evalLimited(prog)
+		if err != nil {
+			return
+		}
+		norm := optimizer.Normalize(prog)
+		got, err := evalLimited(norm)
+		if err != nil {
+			// Temporary variables may exceed the memory limit, and evaluation the timeout
+			return
+		}
+		if got != want {
+			t.Fatalf("evaluating %q normalized to %q: got %q, want %q", src, norm.String(), got, want)
+		}
+	})
+}
@@ -1,189 +0,0 @@
-// Code generated by gocc; DO NOT EDIT.
-
-package lexer
-
-import (
-	"io/ioutil"
-	"unicode/utf8"
-
-	"github.com/skius/stringlang/internal/frontend/token"
-)
-
-const (
-	NoState    = -1
-	NumStates  = 50
-	NumSymbols = 57
-)
-
-type Lexer struct {
-	src     []byte
-	pos     int
-	line    int
-	column  int
-	Context token.Context
-}
-
-func NewLexer(src []byte) *Lexer {
-	lexer := &Lexer{
-		src:     src,
-		pos:     0,
-		line:    1,
-		column:  1,
-		Context: nil,
-	}
-	return lexer
-}
-
-// SourceContext is a simple instance of a token.Context which
-// contains the name of the source file.
-type SourceContext struct {
-	Filepath string
-}
-
-func (s *SourceContext) Source() string {
-	return s.Filepath
-}
-
-func NewLexerFile(fpath string) (*Lexer, error) {
-	src, err := ioutil.ReadFile(fpath)
-	if err != nil {
-		return nil, err
-	}
-	lexer := NewLexer(src)
-	lexer.Context = &SourceContext{Filepath: fpath}
-	return lexer, nil
-}
-
-func (l *Lexer) Scan() (tok *token.Token) {
-	tok = &token.Token{}
-	if l.pos >= len(l.src) {
-		tok.Type = token.EOF
-		tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column = l.pos, l.line, l.column
-		tok.Pos.Context = l.Context
-		return
-	}
-	start, startLine, startColumn, end := l.pos, l.line, l.column, 0
-	tok.Type = token.INVALID
-	state, rune1, size := 0, rune(-1), 0
-	for state != -1 {
-		if l.pos >= len(l.src) {
-			rune1 = -1
-		} else {
-			rune1, size = utf8.DecodeRune(l.src[l.pos:])
-			l.pos += size
-		}
-
-		nextState := -1
-		if rune1 != -1 {
-			nextState = TransTab[state](rune1)
-		}
-		state = nextState
-
-		if state != -1 {
-
-			switch rune1 {
-			case '\n':
-				l.line++
-				l.column = 1
-			case '\r':
-				l.column = 1
-			case '\t':
-				l.column += 4
-			default:
-				l.column++
-			}
-
-			switch {
-			case ActTab[state].Accept != -1:
-				tok.Type = ActTab[state].Accept
-				end = l.pos
-			case ActTab[state].Ignore != "":
-				start, startLine, startColumn = l.pos, l.line, l.column
-				state = 0
-				if start >= len(l.src) {
-					tok.Type = token.EOF
-				}
-
-			}
-		} else {
-			if tok.Type == token.INVALID {
-				end = l.pos
-			}
-		}
-	}
-	if end > start {
-		l.pos = end
-		tok.Lit = l.src[start:end]
-	} else {
-		tok.Lit = []byte{}
-	}
-	tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column = start, startLine, startColumn
-	tok.Pos.Context = l.Context
-
-	return
-}
-
-func (l *Lexer) Reset() {
-	l.pos = 0
-}
-
-/*
-Lexer symbols:
-0: '"'
-1: '"'
-2: 'f'
-3: 'u'
-4: 'n'
-5: '('
-6: ')'
-7: '{'
-8: '}'
-9: ','
-10: ';'
-11: '='
-12: '|'
-13: '|'
-14: '&'
-15: '&'
-16: '!'
-17: '='
-18: '='
-19: '='
-20: '+'
-21: '['
-22: ']'
-23: '%'
-24: 'i'
-25: 'f'
-26: 'e'
-27: 'l'
-28: 's'
-29: 'e'
-30: 'w'
-31: 'h'
-32: 'i'
-33: 'l'
-34: 'e'
-35: '_'
-36: '\'
-37: '"'
-38: '\'
-39: ' '
-40: '\t'
-41: '\n'
-42: '\r'
-43: '/'
-44: '*'
-45: '*'
-46: '*'
-47: '/'
-48: '0'-'9'
-49: 'a'-'z'
-50: 'A'-'Z'
-51: \u0001-'!'
-52: '#'-'['
-53: ']'-\u007f
-54: \u0080-\ufffc
-55: \ufffe-\U0010ffff
-56: .
-*/
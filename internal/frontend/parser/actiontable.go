@@ -1,11054 +0,0 @@
-// Code generated by gocc; DO NOT EDIT.
-
-package parser
-
-type (
-	actionTable [numStates]actionRow
-	actionRow   struct {
-		canRecover bool
-		actions    [numSymbols]action
-	}
-)
-
-var actionTab = actionTable{
-	actionRow{ // S0
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(4), // $, reduce: FuncDecls
-			nil,       // empty
-			reduce(4), // fun, reduce: FuncDecls
-			reduce(4), // id, reduce: FuncDecls
-			reduce(4), // (, reduce: FuncDecls
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			reduce(4), // string_lit, reduce: FuncDecls
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			reduce(4), // %, reduce: FuncDecls
-			reduce(4), // if, reduce: FuncDecls
-			nil,       // else
-			reduce(4), // while, reduce: FuncDecls
-		},
-	},
-	actionRow{ // S1
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,          // INVALID
-			accept(true), // $
-			nil,          // empty
-			nil,          // fun
-			nil,          // id
-			nil,          // (
-			nil,          // )
-			nil,          // {
-			nil,          // }
-			nil,          // ,
-			nil,          // ;
-			nil,          // =
-			nil,          // ||
-			nil,          // &&
-			nil,          // !=
-			nil,          // ==
-			nil,          // +
-			nil,          // string_lit
-			nil,          // [
-			nil,          // ]
-			nil,          // int_lit
-			nil,          // %
-			nil,          // if
-			nil,          // else
-			nil,          // while
-		},
-	},
-	actionRow{ // S2
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(1), // $, reduce: Program
-			nil,       // empty
-			shift(5),  // fun
-			shift(6),  // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S3
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(2), // $, reduce: Program
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			nil,       // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S4
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(3), // $, reduce: FuncDecls
-			nil,       // empty
-			reduce(3), // fun, reduce: FuncDecls
-			reduce(3), // id, reduce: FuncDecls
-			reduce(3), // (, reduce: FuncDecls
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			reduce(3), // string_lit, reduce: FuncDecls
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			reduce(3), // %, reduce: FuncDecls
-			reduce(3), // if, reduce: FuncDecls
-			nil,       // else
-			reduce(3), // while, reduce: FuncDecls
-		},
-	},
-	actionRow{ // S5
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			shift(25), // id
-			shift(26), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S6
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(42), // $, reduce: Var
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(42), // ;, reduce: Var
-			reduce(42), // =, reduce: Var
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S7
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S8
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(12), // $, reduce: BlockHelper
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			shift(48),  // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S9
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(29), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(29), // ;, reduce: ExprLeaf
-			shift(49),  // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S10
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(14), // $, reduce: Expr
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(14), // ;, reduce: Expr
-			nil,        // =
-			shift(50),  // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S11
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(16), // $, reduce: ExprOr
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(16), // ;, reduce: ExprOr
-			nil,        // =
-			reduce(16), // ||, reduce: ExprOr
-			shift(51),  // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S12
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(18), // $, reduce: ExprAnd
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(18), // ;, reduce: ExprAnd
-			nil,        // =
-			reduce(18), // ||, reduce: ExprAnd
-			reduce(18), // &&, reduce: ExprAnd
-			shift(52),  // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S13
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(20), // $, reduce: ExprNotEquals
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(20), // ;, reduce: ExprNotEquals
-			nil,        // =
-			reduce(20), // ||, reduce: ExprNotEquals
-			reduce(20), // &&, reduce: ExprNotEquals
-			reduce(20), // !=, reduce: ExprNotEquals
-			shift(53),  // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S14
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(22), // $, reduce: ExprEquals
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(22), // ;, reduce: ExprEquals
-			nil,        // =
-			reduce(22), // ||, reduce: ExprEquals
-			reduce(22), // &&, reduce: ExprEquals
-			reduce(22), // !=, reduce: ExprEquals
-			reduce(22), // ==, reduce: ExprEquals
-			shift(54),  // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S15
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(24), // $, reduce: ExprConcat
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(55),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(24), // ;, reduce: ExprConcat
-			nil,        // =
-			reduce(24), // ||, reduce: ExprConcat
-			reduce(24), // &&, reduce: ExprConcat
-			reduce(24), // !=, reduce: ExprConcat
-			reduce(24), // ==, reduce: ExprConcat
-			reduce(24), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(56),  // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S16
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(25), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(25), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(25), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(25), // ||, reduce: ExprLeaf
-			reduce(25), // &&, reduce: ExprLeaf
-			reduce(25), // !=, reduce: ExprLeaf
-			reduce(25), // ==, reduce: ExprLeaf
-			reduce(25), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(25), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S17
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(26), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(26), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(26), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(26), // ||, reduce: ExprLeaf
-			reduce(26), // &&, reduce: ExprLeaf
-			reduce(26), // !=, reduce: ExprLeaf
-			reduce(26), // ==, reduce: ExprLeaf
-			reduce(26), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(26), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S18
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(27), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(27), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(27), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(27), // ||, reduce: ExprLeaf
-			reduce(27), // &&, reduce: ExprLeaf
-			reduce(27), // !=, reduce: ExprLeaf
-			reduce(27), // ==, reduce: ExprLeaf
-			reduce(27), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(27), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S19
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(28), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(28), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(28), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(28), // ||, reduce: ExprLeaf
-			reduce(28), // &&, reduce: ExprLeaf
-			reduce(28), // !=, reduce: ExprLeaf
-			reduce(28), // ==, reduce: ExprLeaf
-			reduce(28), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(28), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S20
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(32), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(32), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(32), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(32), // ||, reduce: ExprLeaf
-			reduce(32), // &&, reduce: ExprLeaf
-			reduce(32), // !=, reduce: ExprLeaf
-			reduce(32), // ==, reduce: ExprLeaf
-			reduce(32), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(32), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S21
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(33), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(33), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(33), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(33), // ||, reduce: ExprLeaf
-			reduce(33), // &&, reduce: ExprLeaf
-			reduce(33), // !=, reduce: ExprLeaf
-			reduce(33), // ==, reduce: ExprLeaf
-			reduce(33), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(33), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S22
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			nil,       // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			shift(57), // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S23
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			shift(58), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S24
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			shift(59), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S25
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			shift(60), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S26
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			shift(61), // id
-			nil,       // (
-			reduce(7), // ), reduce: FuncParams
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S27
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			shift(63), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S28
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			reduce(42), // ), reduce: Var
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			reduce(42), // =, reduce: Var
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S29
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S30
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			nil,       // (
-			shift(65), // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S31
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			reduce(29), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			shift(66),  // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S32
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(14), // ), reduce: Expr
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			shift(67),  // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S33
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(16), // ), reduce: ExprOr
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(16), // ||, reduce: ExprOr
-			shift(68),  // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S34
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(18), // ), reduce: ExprAnd
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(18), // ||, reduce: ExprAnd
-			reduce(18), // &&, reduce: ExprAnd
-			shift(69),  // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S35
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(20), // ), reduce: ExprNotEquals
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(20), // ||, reduce: ExprNotEquals
-			reduce(20), // &&, reduce: ExprNotEquals
-			reduce(20), // !=, reduce: ExprNotEquals
-			shift(70),  // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S36
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(22), // ), reduce: ExprEquals
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(22), // ||, reduce: ExprEquals
-			reduce(22), // &&, reduce: ExprEquals
-			reduce(22), // !=, reduce: ExprEquals
-			reduce(22), // ==, reduce: ExprEquals
-			shift(71),  // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S37
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(72),  // (
-			reduce(24), // ), reduce: ExprConcat
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(24), // ||, reduce: ExprConcat
-			reduce(24), // &&, reduce: ExprConcat
-			reduce(24), // !=, reduce: ExprConcat
-			reduce(24), // ==, reduce: ExprConcat
-			reduce(24), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(73),  // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S38
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(25), // (, reduce: ExprLeaf
-			reduce(25), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(25), // ||, reduce: ExprLeaf
-			reduce(25), // &&, reduce: ExprLeaf
-			reduce(25), // !=, reduce: ExprLeaf
-			reduce(25), // ==, reduce: ExprLeaf
-			reduce(25), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(25), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S39
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(26), // (, reduce: ExprLeaf
-			reduce(26), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(26), // ||, reduce: ExprLeaf
-			reduce(26), // &&, reduce: ExprLeaf
-			reduce(26), // !=, reduce: ExprLeaf
-			reduce(26), // ==, reduce: ExprLeaf
-			reduce(26), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(26), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S40
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(27), // (, reduce: ExprLeaf
-			reduce(27), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(27), // ||, reduce: ExprLeaf
-			reduce(27), // &&, reduce: ExprLeaf
-			reduce(27), // !=, reduce: ExprLeaf
-			reduce(27), // ==, reduce: ExprLeaf
-			reduce(27), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(27), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S41
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(28), // (, reduce: ExprLeaf
-			reduce(28), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(28), // ||, reduce: ExprLeaf
-			reduce(28), // &&, reduce: ExprLeaf
-			reduce(28), // !=, reduce: ExprLeaf
-			reduce(28), // ==, reduce: ExprLeaf
-			reduce(28), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(28), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S42
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(32), // (, reduce: ExprLeaf
-			reduce(32), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(32), // ||, reduce: ExprLeaf
-			reduce(32), // &&, reduce: ExprLeaf
-			reduce(32), // !=, reduce: ExprLeaf
-			reduce(32), // ==, reduce: ExprLeaf
-			reduce(32), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(32), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S43
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(33), // (, reduce: ExprLeaf
-			reduce(33), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(33), // ||, reduce: ExprLeaf
-			reduce(33), // &&, reduce: ExprLeaf
-			reduce(33), // !=, reduce: ExprLeaf
-			reduce(33), // ==, reduce: ExprLeaf
-			reduce(33), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(33), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S44
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			nil,       // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			shift(74), // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S45
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			shift(75), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S46
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			shift(76), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S47
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(10), // $, reduce: Block
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S48
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(77), // fun
-			shift(6),  // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S49
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(77), // fun
-			shift(6),  // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S50
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(77), // fun
-			shift(80), // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S51
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(77), // fun
-			shift(80), // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S52
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(77), // fun
-			shift(80), // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S53
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(77), // fun
-			shift(80), // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S54
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(77), // fun
-			shift(80), // id
-			shift(7),  // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(18), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(22), // %
-			shift(23), // if
-			nil,       // else
-			shift(24), // while
-		},
-	},
-	actionRow{ // S55
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(88),  // id
-			shift(89),  // (
-			reduce(38), // ), reduce: CallArgs
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S56
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(109), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(125), // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S57
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(41), // $, reduce: Arg
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(41), // (, reduce: Arg
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(41), // ;, reduce: Arg
-			nil,        // =
-			reduce(41), // ||, reduce: Arg
-			reduce(41), // &&, reduce: Arg
-			reduce(41), // !=, reduce: Arg
-			reduce(41), // ==, reduce: Arg
-			reduce(41), // +, reduce: Arg
-			nil,        // string_lit
-			reduce(41), // [, reduce: Arg
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S58
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S59
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S60
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			shift(61), // id
-			nil,       // (
-			reduce(7), // ), reduce: FuncParams
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S61
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(9),  // ), reduce: FuncParamsHelper
-			nil,        // {
-			nil,        // }
-			shift(133), // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S62
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(134), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S63
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			shift(61), // id
-			nil,       // (
-			reduce(7), // ), reduce: FuncParams
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S64
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(136), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S65
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(31), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(31), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(31), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(31), // ||, reduce: ExprLeaf
-			reduce(31), // &&, reduce: ExprLeaf
-			reduce(31), // !=, reduce: ExprLeaf
-			reduce(31), // ==, reduce: ExprLeaf
-			reduce(31), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(31), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S66
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S67
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(27),  // fun
-			shift(138), // id
-			shift(29),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(40),  // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(44),  // %
-			shift(45),  // if
-			nil,        // else
-			shift(46),  // while
-		},
-	},
-	actionRow{ // S68
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(27),  // fun
-			shift(138), // id
-			shift(29),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(40),  // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(44),  // %
-			shift(45),  // if
-			nil,        // else
-			shift(46),  // while
-		},
-	},
-	actionRow{ // S69
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(27),  // fun
-			shift(138), // id
-			shift(29),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(40),  // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(44),  // %
-			shift(45),  // if
-			nil,        // else
-			shift(46),  // while
-		},
-	},
-	actionRow{ // S70
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(27),  // fun
-			shift(138), // id
-			shift(29),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(40),  // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(44),  // %
-			shift(45),  // if
-			nil,        // else
-			shift(46),  // while
-		},
-	},
-	actionRow{ // S71
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(27),  // fun
-			shift(138), // id
-			shift(29),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(40),  // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(44),  // %
-			shift(45),  // if
-			nil,        // else
-			shift(46),  // while
-		},
-	},
-	actionRow{ // S72
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(88),  // id
-			shift(89),  // (
-			reduce(38), // ), reduce: CallArgs
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S73
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(109), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(147), // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S74
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(41), // (, reduce: Arg
-			reduce(41), // ), reduce: Arg
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(41), // ||, reduce: Arg
-			reduce(41), // &&, reduce: Arg
-			reduce(41), // !=, reduce: Arg
-			reduce(41), // ==, reduce: Arg
-			reduce(41), // +, reduce: Arg
-			nil,        // string_lit
-			reduce(41), // [, reduce: Arg
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S75
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S76
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S77
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			shift(26), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S78
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(12), // $, reduce: BlockHelper
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			shift(48),  // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S79
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(13), // $, reduce: Expr
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(13), // ;, reduce: Expr
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S80
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(42), // $, reduce: Var
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(42), // ;, reduce: Var
-			nil,        // =
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S81
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(29), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(29), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S82
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(15), // $, reduce: ExprOr
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(15), // ;, reduce: ExprOr
-			nil,        // =
-			reduce(15), // ||, reduce: ExprOr
-			shift(51),  // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S83
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(17), // $, reduce: ExprAnd
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(17), // ;, reduce: ExprAnd
-			nil,        // =
-			reduce(17), // ||, reduce: ExprAnd
-			reduce(17), // &&, reduce: ExprAnd
-			shift(52),  // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S84
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(19), // $, reduce: ExprNotEquals
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(19), // ;, reduce: ExprNotEquals
-			nil,        // =
-			reduce(19), // ||, reduce: ExprNotEquals
-			reduce(19), // &&, reduce: ExprNotEquals
-			reduce(19), // !=, reduce: ExprNotEquals
-			shift(53),  // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S85
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(21), // $, reduce: ExprEquals
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(21), // ;, reduce: ExprEquals
-			nil,        // =
-			reduce(21), // ||, reduce: ExprEquals
-			reduce(21), // &&, reduce: ExprEquals
-			reduce(21), // !=, reduce: ExprEquals
-			reduce(21), // ==, reduce: ExprEquals
-			shift(54),  // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S86
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(23), // $, reduce: ExprConcat
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(55),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(23), // ;, reduce: ExprConcat
-			nil,        // =
-			reduce(23), // ||, reduce: ExprConcat
-			reduce(23), // &&, reduce: ExprConcat
-			reduce(23), // !=, reduce: ExprConcat
-			reduce(23), // ==, reduce: ExprConcat
-			reduce(23), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(56),  // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S87
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(151), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S88
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			reduce(42), // ), reduce: Var
-			nil,        // {
-			nil,        // }
-			reduce(42), // ,, reduce: Var
-			nil,        // ;
-			reduce(42), // =, reduce: Var
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S89
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S90
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(40), // ), reduce: CallArgsHelper
-			nil,        // {
-			nil,        // }
-			shift(153), // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S91
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			reduce(29), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(29), // ,, reduce: ExprLeaf
-			nil,        // ;
-			shift(155), // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S92
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(14), // ), reduce: Expr
-			nil,        // {
-			nil,        // }
-			reduce(14), // ,, reduce: Expr
-			nil,        // ;
-			nil,        // =
-			shift(156), // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S93
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(16), // ), reduce: ExprOr
-			nil,        // {
-			nil,        // }
-			reduce(16), // ,, reduce: ExprOr
-			nil,        // ;
-			nil,        // =
-			reduce(16), // ||, reduce: ExprOr
-			shift(157), // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S94
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(18), // ), reduce: ExprAnd
-			nil,        // {
-			nil,        // }
-			reduce(18), // ,, reduce: ExprAnd
-			nil,        // ;
-			nil,        // =
-			reduce(18), // ||, reduce: ExprAnd
-			reduce(18), // &&, reduce: ExprAnd
-			shift(158), // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S95
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(20), // ), reduce: ExprNotEquals
-			nil,        // {
-			nil,        // }
-			reduce(20), // ,, reduce: ExprNotEquals
-			nil,        // ;
-			nil,        // =
-			reduce(20), // ||, reduce: ExprNotEquals
-			reduce(20), // &&, reduce: ExprNotEquals
-			reduce(20), // !=, reduce: ExprNotEquals
-			shift(159), // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S96
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(22), // ), reduce: ExprEquals
-			nil,        // {
-			nil,        // }
-			reduce(22), // ,, reduce: ExprEquals
-			nil,        // ;
-			nil,        // =
-			reduce(22), // ||, reduce: ExprEquals
-			reduce(22), // &&, reduce: ExprEquals
-			reduce(22), // !=, reduce: ExprEquals
-			reduce(22), // ==, reduce: ExprEquals
-			shift(160), // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S97
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(161), // (
-			reduce(24), // ), reduce: ExprConcat
-			nil,        // {
-			nil,        // }
-			reduce(24), // ,, reduce: ExprConcat
-			nil,        // ;
-			nil,        // =
-			reduce(24), // ||, reduce: ExprConcat
-			reduce(24), // &&, reduce: ExprConcat
-			reduce(24), // !=, reduce: ExprConcat
-			reduce(24), // ==, reduce: ExprConcat
-			reduce(24), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(162), // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S98
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(25), // (, reduce: ExprLeaf
-			reduce(25), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(25), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(25), // ||, reduce: ExprLeaf
-			reduce(25), // &&, reduce: ExprLeaf
-			reduce(25), // !=, reduce: ExprLeaf
-			reduce(25), // ==, reduce: ExprLeaf
-			reduce(25), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(25), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S99
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(26), // (, reduce: ExprLeaf
-			reduce(26), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(26), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(26), // ||, reduce: ExprLeaf
-			reduce(26), // &&, reduce: ExprLeaf
-			reduce(26), // !=, reduce: ExprLeaf
-			reduce(26), // ==, reduce: ExprLeaf
-			reduce(26), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(26), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S100
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(27), // (, reduce: ExprLeaf
-			reduce(27), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(27), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(27), // ||, reduce: ExprLeaf
-			reduce(27), // &&, reduce: ExprLeaf
-			reduce(27), // !=, reduce: ExprLeaf
-			reduce(27), // ==, reduce: ExprLeaf
-			reduce(27), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(27), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S101
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(28), // (, reduce: ExprLeaf
-			reduce(28), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(28), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(28), // ||, reduce: ExprLeaf
-			reduce(28), // &&, reduce: ExprLeaf
-			reduce(28), // !=, reduce: ExprLeaf
-			reduce(28), // ==, reduce: ExprLeaf
-			reduce(28), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(28), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S102
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(163), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S103
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(32), // (, reduce: ExprLeaf
-			reduce(32), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(32), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(32), // ||, reduce: ExprLeaf
-			reduce(32), // &&, reduce: ExprLeaf
-			reduce(32), // !=, reduce: ExprLeaf
-			reduce(32), // ==, reduce: ExprLeaf
-			reduce(32), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(32), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S104
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(33), // (, reduce: ExprLeaf
-			reduce(33), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(33), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(33), // ||, reduce: ExprLeaf
-			reduce(33), // &&, reduce: ExprLeaf
-			reduce(33), // !=, reduce: ExprLeaf
-			reduce(33), // ==, reduce: ExprLeaf
-			reduce(33), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(33), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S105
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(164), // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S106
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(165), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S107
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(166), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S108
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(167), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S109
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			reduce(42), // =, reduce: Var
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			reduce(42), // ], reduce: Var
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S110
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S111
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(169), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S112
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			shift(170), // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			reduce(29), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S113
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			shift(171), // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(14), // ], reduce: Expr
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S114
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(16), // ||, reduce: ExprOr
-			shift(172), // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(16), // ], reduce: ExprOr
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S115
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(18), // ||, reduce: ExprAnd
-			reduce(18), // &&, reduce: ExprAnd
-			shift(173), // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(18), // ], reduce: ExprAnd
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S116
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(20), // ||, reduce: ExprNotEquals
-			reduce(20), // &&, reduce: ExprNotEquals
-			reduce(20), // !=, reduce: ExprNotEquals
-			shift(174), // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(20), // ], reduce: ExprNotEquals
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S117
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(22), // ||, reduce: ExprEquals
-			reduce(22), // &&, reduce: ExprEquals
-			reduce(22), // !=, reduce: ExprEquals
-			reduce(22), // ==, reduce: ExprEquals
-			shift(175), // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(22), // ], reduce: ExprEquals
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S118
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(176), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(24), // ||, reduce: ExprConcat
-			reduce(24), // &&, reduce: ExprConcat
-			reduce(24), // !=, reduce: ExprConcat
-			reduce(24), // ==, reduce: ExprConcat
-			reduce(24), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(177), // [
-			reduce(24), // ], reduce: ExprConcat
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S119
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(25), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(25), // ||, reduce: ExprLeaf
-			reduce(25), // &&, reduce: ExprLeaf
-			reduce(25), // !=, reduce: ExprLeaf
-			reduce(25), // ==, reduce: ExprLeaf
-			reduce(25), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(25), // [, reduce: ExprLeaf
-			reduce(25), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S120
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(26), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(26), // ||, reduce: ExprLeaf
-			reduce(26), // &&, reduce: ExprLeaf
-			reduce(26), // !=, reduce: ExprLeaf
-			reduce(26), // ==, reduce: ExprLeaf
-			reduce(26), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(26), // [, reduce: ExprLeaf
-			reduce(26), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S121
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(27), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(27), // ||, reduce: ExprLeaf
-			reduce(27), // &&, reduce: ExprLeaf
-			reduce(27), // !=, reduce: ExprLeaf
-			reduce(27), // ==, reduce: ExprLeaf
-			reduce(27), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(27), // [, reduce: ExprLeaf
-			reduce(27), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S122
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(28), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(28), // ||, reduce: ExprLeaf
-			reduce(28), // &&, reduce: ExprLeaf
-			reduce(28), // !=, reduce: ExprLeaf
-			reduce(28), // ==, reduce: ExprLeaf
-			reduce(28), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(28), // [, reduce: ExprLeaf
-			reduce(28), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S123
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(32), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(32), // ||, reduce: ExprLeaf
-			reduce(32), // &&, reduce: ExprLeaf
-			reduce(32), // !=, reduce: ExprLeaf
-			reduce(32), // ==, reduce: ExprLeaf
-			reduce(32), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(32), // [, reduce: ExprLeaf
-			reduce(32), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S124
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(33), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(33), // ||, reduce: ExprLeaf
-			reduce(33), // &&, reduce: ExprLeaf
-			reduce(33), // !=, reduce: ExprLeaf
-			reduce(33), // ==, reduce: ExprLeaf
-			reduce(33), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(33), // [, reduce: ExprLeaf
-			reduce(33), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S125
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(178), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S126
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(179), // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S127
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(180), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S128
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(181), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S129
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(182), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S130
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(183), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S131
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(184), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S132
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			nil,       // (
-			reduce(6), // ), reduce: FuncParams
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S133
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			shift(185), // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S134
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(186), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S135
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(187), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S136
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(31), // (, reduce: ExprLeaf
-			reduce(31), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(31), // ||, reduce: ExprLeaf
-			reduce(31), // &&, reduce: ExprLeaf
-			reduce(31), // !=, reduce: ExprLeaf
-			reduce(31), // ==, reduce: ExprLeaf
-			reduce(31), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(31), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S137
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(13), // ), reduce: Expr
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S138
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			reduce(42), // ), reduce: Var
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S139
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			reduce(29), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S140
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(15), // ), reduce: ExprOr
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(15), // ||, reduce: ExprOr
-			shift(68),  // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S141
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(17), // ), reduce: ExprAnd
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(17), // ||, reduce: ExprAnd
-			reduce(17), // &&, reduce: ExprAnd
-			shift(69),  // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S142
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(19), // ), reduce: ExprNotEquals
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(19), // ||, reduce: ExprNotEquals
-			reduce(19), // &&, reduce: ExprNotEquals
-			reduce(19), // !=, reduce: ExprNotEquals
-			shift(70),  // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S143
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(21), // ), reduce: ExprEquals
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(21), // ||, reduce: ExprEquals
-			reduce(21), // &&, reduce: ExprEquals
-			reduce(21), // !=, reduce: ExprEquals
-			reduce(21), // ==, reduce: ExprEquals
-			shift(71),  // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S144
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(72),  // (
-			reduce(23), // ), reduce: ExprConcat
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(23), // ||, reduce: ExprConcat
-			reduce(23), // &&, reduce: ExprConcat
-			reduce(23), // !=, reduce: ExprConcat
-			reduce(23), // ==, reduce: ExprConcat
-			reduce(23), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(73),  // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S145
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(188), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S146
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(189), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S147
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(190), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S148
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(191), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S149
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(192), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S150
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(11), // $, reduce: BlockHelper
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S151
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			shift(61), // id
-			nil,       // (
-			reduce(7), // ), reduce: FuncParams
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S152
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(194), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S153
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(88),  // id
-			shift(89),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S154
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(37), // ), reduce: CallArgs
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S155
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(88),  // id
-			shift(89),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S156
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(197), // id
-			shift(89),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S157
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(197), // id
-			shift(89),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S158
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(197), // id
-			shift(89),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S159
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(197), // id
-			shift(89),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S160
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(197), // id
-			shift(89),  // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S161
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(88),  // id
-			shift(89),  // (
-			reduce(38), // ), reduce: CallArgs
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S162
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(109), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(206), // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S163
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(30), // $, reduce: ExprLeaf
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(30), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(30), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(30), // ||, reduce: ExprLeaf
-			reduce(30), // &&, reduce: ExprLeaf
-			reduce(30), // !=, reduce: ExprLeaf
-			reduce(30), // ==, reduce: ExprLeaf
-			reduce(30), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(30), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S164
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(41), // (, reduce: Arg
-			reduce(41), // ), reduce: Arg
-			nil,        // {
-			nil,        // }
-			reduce(41), // ,, reduce: Arg
-			nil,        // ;
-			nil,        // =
-			reduce(41), // ||, reduce: Arg
-			reduce(41), // &&, reduce: Arg
-			reduce(41), // !=, reduce: Arg
-			reduce(41), // ==, reduce: Arg
-			reduce(41), // +, reduce: Arg
-			nil,        // string_lit
-			reduce(41), // [, reduce: Arg
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S165
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S166
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S167
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			shift(61), // id
-			nil,       // (
-			reduce(7), // ), reduce: FuncParams
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S168
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(210), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S169
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(35), // $, reduce: Index
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(35), // (, reduce: Index
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(35), // ;, reduce: Index
-			nil,        // =
-			reduce(35), // ||, reduce: Index
-			reduce(35), // &&, reduce: Index
-			reduce(35), // !=, reduce: Index
-			reduce(35), // ==, reduce: Index
-			reduce(35), // +, reduce: Index
-			nil,        // string_lit
-			reduce(35), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S170
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(109), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S171
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(212), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S172
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(212), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S173
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(212), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S174
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(212), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S175
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(212), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S176
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(88),  // id
-			shift(89),  // (
-			reduce(38), // ), reduce: CallArgs
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S177
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(109), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(221), // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S178
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(36), // $, reduce: Index
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(36), // (, reduce: Index
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(36), // ;, reduce: Index
-			nil,        // =
-			reduce(36), // ||, reduce: Index
-			reduce(36), // &&, reduce: Index
-			reduce(36), // !=, reduce: Index
-			reduce(36), // ==, reduce: Index
-			reduce(36), // +, reduce: Index
-			nil,        // string_lit
-			reduce(36), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S179
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(41), // (, reduce: Arg
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(41), // ||, reduce: Arg
-			reduce(41), // &&, reduce: Arg
-			reduce(41), // !=, reduce: Arg
-			reduce(41), // ==, reduce: Arg
-			reduce(41), // +, reduce: Arg
-			nil,        // string_lit
-			reduce(41), // [, reduce: Arg
-			reduce(41), // ], reduce: Arg
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S180
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S181
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S182
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(224), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S183
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(225), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S184
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(226), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S185
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(9),  // ), reduce: FuncParamsHelper
-			nil,        // {
-			nil,        // }
-			shift(133), // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S186
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S187
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(249), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S188
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(30), // (, reduce: ExprLeaf
-			reduce(30), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(30), // ||, reduce: ExprLeaf
-			reduce(30), // &&, reduce: ExprLeaf
-			reduce(30), // !=, reduce: ExprLeaf
-			reduce(30), // ==, reduce: ExprLeaf
-			reduce(30), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(30), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S189
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(35), // (, reduce: Index
-			reduce(35), // ), reduce: Index
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(35), // ||, reduce: Index
-			reduce(35), // &&, reduce: Index
-			reduce(35), // !=, reduce: Index
-			reduce(35), // ==, reduce: Index
-			reduce(35), // +, reduce: Index
-			nil,        // string_lit
-			reduce(35), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S190
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(36), // (, reduce: Index
-			reduce(36), // ), reduce: Index
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(36), // ||, reduce: Index
-			reduce(36), // &&, reduce: Index
-			reduce(36), // !=, reduce: Index
-			reduce(36), // ==, reduce: Index
-			reduce(36), // +, reduce: Index
-			nil,        // string_lit
-			reduce(36), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S191
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(250), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S192
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(251), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S193
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(252), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S194
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(31), // (, reduce: ExprLeaf
-			reduce(31), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(31), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(31), // ||, reduce: ExprLeaf
-			reduce(31), // &&, reduce: ExprLeaf
-			reduce(31), // !=, reduce: ExprLeaf
-			reduce(31), // ==, reduce: ExprLeaf
-			reduce(31), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(31), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S195
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(40), // ), reduce: CallArgsHelper
-			nil,        // {
-			nil,        // }
-			shift(153), // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S196
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(13), // ), reduce: Expr
-			nil,        // {
-			nil,        // }
-			reduce(13), // ,, reduce: Expr
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S197
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			reduce(42), // ), reduce: Var
-			nil,        // {
-			nil,        // }
-			reduce(42), // ,, reduce: Var
-			nil,        // ;
-			nil,        // =
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S198
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			reduce(29), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(29), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S199
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(15), // ), reduce: ExprOr
-			nil,        // {
-			nil,        // }
-			reduce(15), // ,, reduce: ExprOr
-			nil,        // ;
-			nil,        // =
-			reduce(15), // ||, reduce: ExprOr
-			shift(157), // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S200
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(17), // ), reduce: ExprAnd
-			nil,        // {
-			nil,        // }
-			reduce(17), // ,, reduce: ExprAnd
-			nil,        // ;
-			nil,        // =
-			reduce(17), // ||, reduce: ExprAnd
-			reduce(17), // &&, reduce: ExprAnd
-			shift(158), // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S201
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(19), // ), reduce: ExprNotEquals
-			nil,        // {
-			nil,        // }
-			reduce(19), // ,, reduce: ExprNotEquals
-			nil,        // ;
-			nil,        // =
-			reduce(19), // ||, reduce: ExprNotEquals
-			reduce(19), // &&, reduce: ExprNotEquals
-			reduce(19), // !=, reduce: ExprNotEquals
-			shift(159), // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S202
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(21), // ), reduce: ExprEquals
-			nil,        // {
-			nil,        // }
-			reduce(21), // ,, reduce: ExprEquals
-			nil,        // ;
-			nil,        // =
-			reduce(21), // ||, reduce: ExprEquals
-			reduce(21), // &&, reduce: ExprEquals
-			reduce(21), // !=, reduce: ExprEquals
-			reduce(21), // ==, reduce: ExprEquals
-			shift(160), // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S203
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(161), // (
-			reduce(23), // ), reduce: ExprConcat
-			nil,        // {
-			nil,        // }
-			reduce(23), // ,, reduce: ExprConcat
-			nil,        // ;
-			nil,        // =
-			reduce(23), // ||, reduce: ExprConcat
-			reduce(23), // &&, reduce: ExprConcat
-			reduce(23), // !=, reduce: ExprConcat
-			reduce(23), // ==, reduce: ExprConcat
-			reduce(23), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(162), // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S204
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(254), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S205
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(255), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S206
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(256), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S207
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(257), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S208
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(258), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S209
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(259), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S210
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(31), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(31), // ||, reduce: ExprLeaf
-			reduce(31), // &&, reduce: ExprLeaf
-			reduce(31), // !=, reduce: ExprLeaf
-			reduce(31), // ==, reduce: ExprLeaf
-			reduce(31), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(31), // [, reduce: ExprLeaf
-			reduce(31), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S211
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(13), // ], reduce: Expr
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S212
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			reduce(42), // ], reduce: Var
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S213
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			reduce(29), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S214
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(15), // ||, reduce: ExprOr
-			shift(172), // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(15), // ], reduce: ExprOr
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S215
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(17), // ||, reduce: ExprAnd
-			reduce(17), // &&, reduce: ExprAnd
-			shift(173), // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(17), // ], reduce: ExprAnd
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S216
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(19), // ||, reduce: ExprNotEquals
-			reduce(19), // &&, reduce: ExprNotEquals
-			reduce(19), // !=, reduce: ExprNotEquals
-			shift(174), // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(19), // ], reduce: ExprNotEquals
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S217
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(21), // ||, reduce: ExprEquals
-			reduce(21), // &&, reduce: ExprEquals
-			reduce(21), // !=, reduce: ExprEquals
-			reduce(21), // ==, reduce: ExprEquals
-			shift(175), // +
-			nil,        // string_lit
-			nil,        // [
-			reduce(21), // ], reduce: ExprEquals
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S218
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(176), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(23), // ||, reduce: ExprConcat
-			reduce(23), // &&, reduce: ExprConcat
-			reduce(23), // !=, reduce: ExprConcat
-			reduce(23), // ==, reduce: ExprConcat
-			reduce(23), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(177), // [
-			reduce(23), // ], reduce: ExprConcat
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S219
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(260), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S220
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(261), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S221
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(262), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S222
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(263), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S223
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(264), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S224
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S225
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S226
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S227
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			nil,       // id
-			nil,       // (
-			reduce(8), // ), reduce: FuncParamsHelper
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S228
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(268), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S229
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(269), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S230
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			nil,        // )
-			nil,        // {
-			reduce(42), // }, reduce: Var
-			nil,        // ,
-			reduce(42), // ;, reduce: Var
-			reduce(42), // =, reduce: Var
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S231
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S232
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(12), // }, reduce: BlockHelper
-			nil,        // ,
-			shift(272), // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S233
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(29), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(29), // ;, reduce: ExprLeaf
-			shift(273), // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S234
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(14), // }, reduce: Expr
-			nil,        // ,
-			reduce(14), // ;, reduce: Expr
-			nil,        // =
-			shift(274), // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S235
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(16), // }, reduce: ExprOr
-			nil,        // ,
-			reduce(16), // ;, reduce: ExprOr
-			nil,        // =
-			reduce(16), // ||, reduce: ExprOr
-			shift(275), // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S236
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(18), // }, reduce: ExprAnd
-			nil,        // ,
-			reduce(18), // ;, reduce: ExprAnd
-			nil,        // =
-			reduce(18), // ||, reduce: ExprAnd
-			reduce(18), // &&, reduce: ExprAnd
-			shift(276), // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S237
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(20), // }, reduce: ExprNotEquals
-			nil,        // ,
-			reduce(20), // ;, reduce: ExprNotEquals
-			nil,        // =
-			reduce(20), // ||, reduce: ExprNotEquals
-			reduce(20), // &&, reduce: ExprNotEquals
-			reduce(20), // !=, reduce: ExprNotEquals
-			shift(277), // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S238
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(22), // }, reduce: ExprEquals
-			nil,        // ,
-			reduce(22), // ;, reduce: ExprEquals
-			nil,        // =
-			reduce(22), // ||, reduce: ExprEquals
-			reduce(22), // &&, reduce: ExprEquals
-			reduce(22), // !=, reduce: ExprEquals
-			reduce(22), // ==, reduce: ExprEquals
-			shift(278), // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S239
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(279), // (
-			nil,        // )
-			nil,        // {
-			reduce(24), // }, reduce: ExprConcat
-			nil,        // ,
-			reduce(24), // ;, reduce: ExprConcat
-			nil,        // =
-			reduce(24), // ||, reduce: ExprConcat
-			reduce(24), // &&, reduce: ExprConcat
-			reduce(24), // !=, reduce: ExprConcat
-			reduce(24), // ==, reduce: ExprConcat
-			reduce(24), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(280), // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S240
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(25), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(25), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(25), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(25), // ||, reduce: ExprLeaf
-			reduce(25), // &&, reduce: ExprLeaf
-			reduce(25), // !=, reduce: ExprLeaf
-			reduce(25), // ==, reduce: ExprLeaf
-			reduce(25), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(25), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S241
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(26), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(26), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(26), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(26), // ||, reduce: ExprLeaf
-			reduce(26), // &&, reduce: ExprLeaf
-			reduce(26), // !=, reduce: ExprLeaf
-			reduce(26), // ==, reduce: ExprLeaf
-			reduce(26), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(26), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S242
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(27), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(27), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(27), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(27), // ||, reduce: ExprLeaf
-			reduce(27), // &&, reduce: ExprLeaf
-			reduce(27), // !=, reduce: ExprLeaf
-			reduce(27), // ==, reduce: ExprLeaf
-			reduce(27), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(27), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S243
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(28), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(28), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(28), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(28), // ||, reduce: ExprLeaf
-			reduce(28), // &&, reduce: ExprLeaf
-			reduce(28), // !=, reduce: ExprLeaf
-			reduce(28), // ==, reduce: ExprLeaf
-			reduce(28), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(28), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S244
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(32), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(32), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(32), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(32), // ||, reduce: ExprLeaf
-			reduce(32), // &&, reduce: ExprLeaf
-			reduce(32), // !=, reduce: ExprLeaf
-			reduce(32), // ==, reduce: ExprLeaf
-			reduce(32), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(32), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S245
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(33), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(33), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(33), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(33), // ||, reduce: ExprLeaf
-			reduce(33), // &&, reduce: ExprLeaf
-			reduce(33), // !=, reduce: ExprLeaf
-			reduce(33), // ==, reduce: ExprLeaf
-			reduce(33), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(33), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S246
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(281), // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S247
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(282), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S248
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(283), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S249
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S250
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S251
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S252
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(287), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S253
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			reduce(39), // ), reduce: CallArgsHelper
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S254
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(30), // (, reduce: ExprLeaf
-			reduce(30), // ), reduce: ExprLeaf
-			nil,        // {
-			nil,        // }
-			reduce(30), // ,, reduce: ExprLeaf
-			nil,        // ;
-			nil,        // =
-			reduce(30), // ||, reduce: ExprLeaf
-			reduce(30), // &&, reduce: ExprLeaf
-			reduce(30), // !=, reduce: ExprLeaf
-			reduce(30), // ==, reduce: ExprLeaf
-			reduce(30), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(30), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S255
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(35), // (, reduce: Index
-			reduce(35), // ), reduce: Index
-			nil,        // {
-			nil,        // }
-			reduce(35), // ,, reduce: Index
-			nil,        // ;
-			nil,        // =
-			reduce(35), // ||, reduce: Index
-			reduce(35), // &&, reduce: Index
-			reduce(35), // !=, reduce: Index
-			reduce(35), // ==, reduce: Index
-			reduce(35), // +, reduce: Index
-			nil,        // string_lit
-			reduce(35), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S256
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(36), // (, reduce: Index
-			reduce(36), // ), reduce: Index
-			nil,        // {
-			nil,        // }
-			reduce(36), // ,, reduce: Index
-			nil,        // ;
-			nil,        // =
-			reduce(36), // ||, reduce: Index
-			reduce(36), // &&, reduce: Index
-			reduce(36), // !=, reduce: Index
-			reduce(36), // ==, reduce: Index
-			reduce(36), // +, reduce: Index
-			nil,        // string_lit
-			reduce(36), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S257
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(288), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S258
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(289), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S259
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(290), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S260
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(30), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(30), // ||, reduce: ExprLeaf
-			reduce(30), // &&, reduce: ExprLeaf
-			reduce(30), // !=, reduce: ExprLeaf
-			reduce(30), // ==, reduce: ExprLeaf
-			reduce(30), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(30), // [, reduce: ExprLeaf
-			reduce(30), // ], reduce: ExprLeaf
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S261
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(35), // (, reduce: Index
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(35), // ||, reduce: Index
-			reduce(35), // &&, reduce: Index
-			reduce(35), // !=, reduce: Index
-			reduce(35), // ==, reduce: Index
-			reduce(35), // +, reduce: Index
-			nil,        // string_lit
-			reduce(35), // [, reduce: Index
-			reduce(35), // ], reduce: Index
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S262
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(36), // (, reduce: Index
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(36), // ||, reduce: Index
-			reduce(36), // &&, reduce: Index
-			reduce(36), // !=, reduce: Index
-			reduce(36), // ==, reduce: Index
-			reduce(36), // +, reduce: Index
-			nil,        // string_lit
-			reduce(36), // [, reduce: Index
-			reduce(36), // ], reduce: Index
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S263
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(291), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S264
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(292), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S265
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(293), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S266
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(294), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S267
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(295), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S268
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(34), // $, reduce: Lambda
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(34), // (, reduce: Lambda
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(34), // ;, reduce: Lambda
-			nil,        // =
-			reduce(34), // ||, reduce: Lambda
-			reduce(34), // &&, reduce: Lambda
-			reduce(34), // !=, reduce: Lambda
-			reduce(34), // ==, reduce: Lambda
-			reduce(34), // +, reduce: Lambda
-			nil,        // string_lit
-			reduce(34), // [, reduce: Lambda
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S269
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			nil,       // fun
-			shift(61), // id
-			nil,       // (
-			reduce(7), // ), reduce: FuncParams
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			nil,       // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			nil,       // %
-			nil,       // if
-			nil,       // else
-			nil,       // while
-		},
-	},
-	actionRow{ // S270
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(297), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S271
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(10), // }, reduce: Block
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S272
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S273
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S274
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(300), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S275
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(300), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S276
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(300), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S277
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(300), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S278
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(300), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S279
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(87),  // fun
-			shift(88),  // id
-			shift(89),  // (
-			reduce(38), // ), reduce: CallArgs
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(100), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(105), // %
-			shift(106), // if
-			nil,        // else
-			shift(107), // while
-		},
-	},
-	actionRow{ // S280
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(108), // fun
-			shift(109), // id
-			shift(110), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(121), // string_lit
-			nil,        // [
-			nil,        // ]
-			shift(309), // int_lit
-			shift(126), // %
-			shift(127), // if
-			nil,        // else
-			shift(128), // while
-		},
-	},
-	actionRow{ // S281
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(41), // (, reduce: Arg
-			nil,        // )
-			nil,        // {
-			reduce(41), // }, reduce: Arg
-			nil,        // ,
-			reduce(41), // ;, reduce: Arg
-			nil,        // =
-			reduce(41), // ||, reduce: Arg
-			reduce(41), // &&, reduce: Arg
-			reduce(41), // !=, reduce: Arg
-			reduce(41), // ==, reduce: Arg
-			reduce(41), // +, reduce: Arg
-			nil,        // string_lit
-			reduce(41), // [, reduce: Arg
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S282
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S283
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			nil,       // $
-			nil,       // empty
-			shift(27), // fun
-			shift(28), // id
-			shift(29), // (
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			shift(40), // string_lit
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			shift(44), // %
-			shift(45), // if
-			nil,       // else
-			shift(46), // while
-		},
-	},
-	actionRow{ // S284
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(312), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S285
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(313), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S286
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(314), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S287
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S288
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S289
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S290
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S291
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S292
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S293
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			shift(321), // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S294
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(45), // $, reduce: While
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(45), // (, reduce: While
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(45), // ;, reduce: While
-			nil,        // =
-			reduce(45), // ||, reduce: While
-			reduce(45), // &&, reduce: While
-			reduce(45), // !=, reduce: While
-			reduce(45), // ==, reduce: While
-			reduce(45), // +, reduce: While
-			nil,        // string_lit
-			reduce(45), // [, reduce: While
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S295
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,       // INVALID
-			reduce(5), // $, reduce: FuncDecl
-			nil,       // empty
-			reduce(5), // fun, reduce: FuncDecl
-			reduce(5), // id, reduce: FuncDecl
-			reduce(5), // (, reduce: FuncDecl
-			nil,       // )
-			nil,       // {
-			nil,       // }
-			nil,       // ,
-			nil,       // ;
-			nil,       // =
-			nil,       // ||
-			nil,       // &&
-			nil,       // !=
-			nil,       // ==
-			nil,       // +
-			reduce(5), // string_lit, reduce: FuncDecl
-			nil,       // [
-			nil,       // ]
-			nil,       // int_lit
-			reduce(5), // %, reduce: FuncDecl
-			reduce(5), // if, reduce: FuncDecl
-			nil,       // else
-			reduce(5), // while, reduce: FuncDecl
-		},
-	},
-	actionRow{ // S296
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(322), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S297
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(31), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(31), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(31), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(31), // ||, reduce: ExprLeaf
-			reduce(31), // &&, reduce: ExprLeaf
-			reduce(31), // !=, reduce: ExprLeaf
-			reduce(31), // ==, reduce: ExprLeaf
-			reduce(31), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(31), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S298
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(12), // }, reduce: BlockHelper
-			nil,        // ,
-			shift(272), // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S299
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(13), // }, reduce: Expr
-			nil,        // ,
-			reduce(13), // ;, reduce: Expr
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S300
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(42), // (, reduce: Var
-			nil,        // )
-			nil,        // {
-			reduce(42), // }, reduce: Var
-			nil,        // ,
-			reduce(42), // ;, reduce: Var
-			nil,        // =
-			reduce(42), // ||, reduce: Var
-			reduce(42), // &&, reduce: Var
-			reduce(42), // !=, reduce: Var
-			reduce(42), // ==, reduce: Var
-			reduce(42), // +, reduce: Var
-			nil,        // string_lit
-			reduce(42), // [, reduce: Var
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S301
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(29), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(29), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(29), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(29), // ||, reduce: ExprLeaf
-			reduce(29), // &&, reduce: ExprLeaf
-			reduce(29), // !=, reduce: ExprLeaf
-			reduce(29), // ==, reduce: ExprLeaf
-			reduce(29), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(29), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S302
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(15), // }, reduce: ExprOr
-			nil,        // ,
-			reduce(15), // ;, reduce: ExprOr
-			nil,        // =
-			reduce(15), // ||, reduce: ExprOr
-			shift(275), // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S303
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(17), // }, reduce: ExprAnd
-			nil,        // ,
-			reduce(17), // ;, reduce: ExprAnd
-			nil,        // =
-			reduce(17), // ||, reduce: ExprAnd
-			reduce(17), // &&, reduce: ExprAnd
-			shift(276), // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S304
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(19), // }, reduce: ExprNotEquals
-			nil,        // ,
-			reduce(19), // ;, reduce: ExprNotEquals
-			nil,        // =
-			reduce(19), // ||, reduce: ExprNotEquals
-			reduce(19), // &&, reduce: ExprNotEquals
-			reduce(19), // !=, reduce: ExprNotEquals
-			shift(277), // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S305
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(21), // }, reduce: ExprEquals
-			nil,        // ,
-			reduce(21), // ;, reduce: ExprEquals
-			nil,        // =
-			reduce(21), // ||, reduce: ExprEquals
-			reduce(21), // &&, reduce: ExprEquals
-			reduce(21), // !=, reduce: ExprEquals
-			reduce(21), // ==, reduce: ExprEquals
-			shift(278), // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S306
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			shift(279), // (
-			nil,        // )
-			nil,        // {
-			reduce(23), // }, reduce: ExprConcat
-			nil,        // ,
-			reduce(23), // ;, reduce: ExprConcat
-			nil,        // =
-			reduce(23), // ||, reduce: ExprConcat
-			reduce(23), // &&, reduce: ExprConcat
-			reduce(23), // !=, reduce: ExprConcat
-			reduce(23), // ==, reduce: ExprConcat
-			reduce(23), // +, reduce: ExprConcat
-			nil,        // string_lit
-			shift(280), // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S307
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(324), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S308
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(325), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S309
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			shift(326), // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S310
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(327), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S311
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			shift(328), // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S312
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(34), // (, reduce: Lambda
-			reduce(34), // ), reduce: Lambda
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(34), // ||, reduce: Lambda
-			reduce(34), // &&, reduce: Lambda
-			reduce(34), // !=, reduce: Lambda
-			reduce(34), // ==, reduce: Lambda
-			reduce(34), // +, reduce: Lambda
-			nil,        // string_lit
-			reduce(34), // [, reduce: Lambda
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S313
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			shift(329), // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S314
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(45), // (, reduce: While
-			reduce(45), // ), reduce: While
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(45), // ||, reduce: While
-			reduce(45), // &&, reduce: While
-			reduce(45), // !=, reduce: While
-			reduce(45), // ==, reduce: While
-			reduce(45), // +, reduce: While
-			nil,        // string_lit
-			reduce(45), // [, reduce: While
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S315
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(330), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S316
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(331), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S317
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(332), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S318
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(333), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S319
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(334), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S320
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(335), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S321
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(336), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			shift(23),  // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S322
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(338), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S323
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			reduce(11), // }, reduce: BlockHelper
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S324
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(30), // (, reduce: ExprLeaf
-			nil,        // )
-			nil,        // {
-			reduce(30), // }, reduce: ExprLeaf
-			nil,        // ,
-			reduce(30), // ;, reduce: ExprLeaf
-			nil,        // =
-			reduce(30), // ||, reduce: ExprLeaf
-			reduce(30), // &&, reduce: ExprLeaf
-			reduce(30), // !=, reduce: ExprLeaf
-			reduce(30), // ==, reduce: ExprLeaf
-			reduce(30), // +, reduce: ExprLeaf
-			nil,        // string_lit
-			reduce(30), // [, reduce: ExprLeaf
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S325
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(35), // (, reduce: Index
-			nil,        // )
-			nil,        // {
-			reduce(35), // }, reduce: Index
-			nil,        // ,
-			reduce(35), // ;, reduce: Index
-			nil,        // =
-			reduce(35), // ||, reduce: Index
-			reduce(35), // &&, reduce: Index
-			reduce(35), // !=, reduce: Index
-			reduce(35), // ==, reduce: Index
-			reduce(35), // +, reduce: Index
-			nil,        // string_lit
-			reduce(35), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S326
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(36), // (, reduce: Index
-			nil,        // )
-			nil,        // {
-			reduce(36), // }, reduce: Index
-			nil,        // ,
-			reduce(36), // ;, reduce: Index
-			nil,        // =
-			reduce(36), // ||, reduce: Index
-			reduce(36), // &&, reduce: Index
-			reduce(36), // !=, reduce: Index
-			reduce(36), // ==, reduce: Index
-			reduce(36), // +, reduce: Index
-			nil,        // string_lit
-			reduce(36), // [, reduce: Index
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S327
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(339), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S328
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(340), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S329
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(341), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			shift(45),  // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S330
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(34), // (, reduce: Lambda
-			reduce(34), // ), reduce: Lambda
-			nil,        // {
-			nil,        // }
-			reduce(34), // ,, reduce: Lambda
-			nil,        // ;
-			nil,        // =
-			reduce(34), // ||, reduce: Lambda
-			reduce(34), // &&, reduce: Lambda
-			reduce(34), // !=, reduce: Lambda
-			reduce(34), // ==, reduce: Lambda
-			reduce(34), // +, reduce: Lambda
-			nil,        // string_lit
-			reduce(34), // [, reduce: Lambda
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S331
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			shift(343), // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S332
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(45), // (, reduce: While
-			reduce(45), // ), reduce: While
-			nil,        // {
-			nil,        // }
-			reduce(45), // ,, reduce: While
-			nil,        // ;
-			nil,        // =
-			reduce(45), // ||, reduce: While
-			reduce(45), // &&, reduce: While
-			reduce(45), // !=, reduce: While
-			reduce(45), // ==, reduce: While
-			reduce(45), // +, reduce: While
-			nil,        // string_lit
-			reduce(45), // [, reduce: While
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S333
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(34), // (, reduce: Lambda
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(34), // ||, reduce: Lambda
-			reduce(34), // &&, reduce: Lambda
-			reduce(34), // !=, reduce: Lambda
-			reduce(34), // ==, reduce: Lambda
-			reduce(34), // +, reduce: Lambda
-			nil,        // string_lit
-			reduce(34), // [, reduce: Lambda
-			reduce(34), // ], reduce: Lambda
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S334
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			shift(344), // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S335
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(45), // (, reduce: While
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(45), // ||, reduce: While
-			reduce(45), // &&, reduce: While
-			reduce(45), // !=, reduce: While
-			reduce(45), // ==, reduce: While
-			reduce(45), // +, reduce: While
-			nil,        // string_lit
-			reduce(45), // [, reduce: While
-			reduce(45), // ], reduce: While
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S336
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S337
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(44), // $, reduce: IfElse
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(44), // (, reduce: IfElse
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(44), // ;, reduce: IfElse
-			nil,        // =
-			reduce(44), // ||, reduce: IfElse
-			reduce(44), // &&, reduce: IfElse
-			reduce(44), // !=, reduce: IfElse
-			reduce(44), // ==, reduce: IfElse
-			reduce(44), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(44), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S338
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S339
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S340
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S341
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S342
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(44), // (, reduce: IfElse
-			reduce(44), // ), reduce: IfElse
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(44), // ||, reduce: IfElse
-			reduce(44), // &&, reduce: IfElse
-			reduce(44), // !=, reduce: IfElse
-			reduce(44), // ==, reduce: IfElse
-			reduce(44), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(44), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S343
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(350), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			shift(106), // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S344
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(352), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			shift(127), // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S345
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(354), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S346
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(355), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S347
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(356), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S348
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(357), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S349
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(358), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S350
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S351
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(44), // (, reduce: IfElse
-			reduce(44), // ), reduce: IfElse
-			nil,        // {
-			nil,        // }
-			reduce(44), // ,, reduce: IfElse
-			nil,        // ;
-			nil,        // =
-			reduce(44), // ||, reduce: IfElse
-			reduce(44), // &&, reduce: IfElse
-			reduce(44), // !=, reduce: IfElse
-			reduce(44), // ==, reduce: IfElse
-			reduce(44), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(44), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S352
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S353
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(44), // (, reduce: IfElse
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(44), // ||, reduce: IfElse
-			reduce(44), // &&, reduce: IfElse
-			reduce(44), // !=, reduce: IfElse
-			reduce(44), // ==, reduce: IfElse
-			reduce(44), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(44), // [, reduce: IfElse
-			reduce(44), // ], reduce: IfElse
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S354
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			reduce(43), // $, reduce: IfElse
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(43), // (, reduce: IfElse
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			reduce(43), // ;, reduce: IfElse
-			nil,        // =
-			reduce(43), // ||, reduce: IfElse
-			reduce(43), // &&, reduce: IfElse
-			reduce(43), // !=, reduce: IfElse
-			reduce(43), // ==, reduce: IfElse
-			reduce(43), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(43), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S355
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(34), // (, reduce: Lambda
-			nil,        // )
-			nil,        // {
-			reduce(34), // }, reduce: Lambda
-			nil,        // ,
-			reduce(34), // ;, reduce: Lambda
-			nil,        // =
-			reduce(34), // ||, reduce: Lambda
-			reduce(34), // &&, reduce: Lambda
-			reduce(34), // !=, reduce: Lambda
-			reduce(34), // ==, reduce: Lambda
-			reduce(34), // +, reduce: Lambda
-			nil,        // string_lit
-			reduce(34), // [, reduce: Lambda
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S356
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			shift(361), // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S357
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(45), // (, reduce: While
-			nil,        // )
-			nil,        // {
-			reduce(45), // }, reduce: While
-			nil,        // ,
-			reduce(45), // ;, reduce: While
-			nil,        // =
-			reduce(45), // ||, reduce: While
-			reduce(45), // &&, reduce: While
-			reduce(45), // !=, reduce: While
-			reduce(45), // ==, reduce: While
-			reduce(45), // +, reduce: While
-			nil,        // string_lit
-			reduce(45), // [, reduce: While
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S358
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(43), // (, reduce: IfElse
-			reduce(43), // ), reduce: IfElse
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(43), // ||, reduce: IfElse
-			reduce(43), // &&, reduce: IfElse
-			reduce(43), // !=, reduce: IfElse
-			reduce(43), // ==, reduce: IfElse
-			reduce(43), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(43), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S359
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(362), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S360
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(363), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S361
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			shift(364), // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			shift(247), // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S362
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(43), // (, reduce: IfElse
-			reduce(43), // ), reduce: IfElse
-			nil,        // {
-			nil,        // }
-			reduce(43), // ,, reduce: IfElse
-			nil,        // ;
-			nil,        // =
-			reduce(43), // ||, reduce: IfElse
-			reduce(43), // &&, reduce: IfElse
-			reduce(43), // !=, reduce: IfElse
-			reduce(43), // ==, reduce: IfElse
-			reduce(43), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(43), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S363
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(43), // (, reduce: IfElse
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			reduce(43), // ||, reduce: IfElse
-			reduce(43), // &&, reduce: IfElse
-			reduce(43), // !=, reduce: IfElse
-			reduce(43), // ==, reduce: IfElse
-			reduce(43), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(43), // [, reduce: IfElse
-			reduce(43), // ], reduce: IfElse
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S364
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			shift(229), // fun
-			shift(230), // id
-			shift(231), // (
-			nil,        // )
-			nil,        // {
-			nil,        // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			shift(242), // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			shift(246), // %
-			shift(247), // if
-			nil,        // else
-			shift(248), // while
-		},
-	},
-	actionRow{ // S365
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(44), // (, reduce: IfElse
-			nil,        // )
-			nil,        // {
-			reduce(44), // }, reduce: IfElse
-			nil,        // ,
-			reduce(44), // ;, reduce: IfElse
-			nil,        // =
-			reduce(44), // ||, reduce: IfElse
-			reduce(44), // &&, reduce: IfElse
-			reduce(44), // !=, reduce: IfElse
-			reduce(44), // ==, reduce: IfElse
-			reduce(44), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(44), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S366
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			nil,        // (
-			nil,        // )
-			nil,        // {
-			shift(367), // }
-			nil,        // ,
-			nil,        // ;
-			nil,        // =
-			nil,        // ||
-			nil,        // &&
-			nil,        // !=
-			nil,        // ==
-			nil,        // +
-			nil,        // string_lit
-			nil,        // [
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-	actionRow{ // S367
-		canRecover: false,
-		actions: [numSymbols]action{
-			nil,        // INVALID
-			nil,        // $
-			nil,        // empty
-			nil,        // fun
-			nil,        // id
-			reduce(43), // (, reduce: IfElse
-			nil,        // )
-			nil,        // {
-			reduce(43), // }, reduce: IfElse
-			nil,        // ,
-			reduce(43), // ;, reduce: IfElse
-			nil,        // =
-			reduce(43), // ||, reduce: IfElse
-			reduce(43), // &&, reduce: IfElse
-			reduce(43), // !=, reduce: IfElse
-			reduce(43), // ==, reduce: IfElse
-			reduce(43), // +, reduce: IfElse
-			nil,        // string_lit
-			reduce(43), // [, reduce: IfElse
-			nil,        // ]
-			nil,        // int_lit
-			nil,        // %
-			nil,        // if
-			nil,        // else
-			nil,        // while
-		},
-	},
-}
@@ -1,7 +0,0 @@
-// Code generated by gocc; DO NOT EDIT.
-
-package parser
-
-// Parser-specific user-defined and entirely-optional context,
-// accessible as '$Context' in SDT actions.
-type Context interface{}
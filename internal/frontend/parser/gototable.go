@@ -1,9213 +0,0 @@
-// Code generated by gocc; DO NOT EDIT.
-
-package parser
-
-const numNTSymbols = 23
-
-type (
-	gotoTable [numStates]gotoRow
-	gotoRow   [numNTSymbols]int
-)
-
-var gotoTab = gotoTable{
-	gotoRow{ // S0
-		-1, // S'
-		1,  // Program
-		2,  // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S1
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S2
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		4,  // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		3,  // Block
-		-1, // BlockHelper
-		8,  // Expr
-		10, // ExprOr
-		11, // ExprAnd
-		12, // ExprNotEquals
-		13, // ExprEquals
-		14, // ExprConcat
-		15, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		9,  // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S3
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S4
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S5
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S6
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S7
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		30, // Expr
-		32, // ExprOr
-		33, // ExprAnd
-		34, // ExprNotEquals
-		35, // ExprEquals
-		36, // ExprConcat
-		37, // ExprLeaf
-		43, // Lambda
-		42, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		41, // Arg
-		31, // Var
-		38, // IfElse
-		39, // While
-	},
-	gotoRow{ // S8
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		47, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S9
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S10
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S11
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S12
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S13
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S14
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S15
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S16
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S17
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S18
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S19
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S20
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S21
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S22
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S23
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S24
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S25
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S26
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		62, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S27
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S28
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S29
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		64, // Expr
-		32, // ExprOr
-		33, // ExprAnd
-		34, // ExprNotEquals
-		35, // ExprEquals
-		36, // ExprConcat
-		37, // ExprLeaf
-		43, // Lambda
-		42, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		41, // Arg
-		31, // Var
-		38, // IfElse
-		39, // While
-	},
-	gotoRow{ // S30
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S31
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S32
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S33
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S34
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S35
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S36
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S37
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S38
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S39
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S40
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S41
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S42
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S43
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S44
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S45
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S46
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S47
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S48
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		78, // Expr
-		10, // ExprOr
-		11, // ExprAnd
-		12, // ExprNotEquals
-		13, // ExprEquals
-		14, // ExprConcat
-		15, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		9,  // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S49
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		79, // Expr
-		10, // ExprOr
-		11, // ExprAnd
-		12, // ExprNotEquals
-		13, // ExprEquals
-		14, // ExprConcat
-		15, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		9,  // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S50
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		82, // ExprAnd
-		12, // ExprNotEquals
-		13, // ExprEquals
-		14, // ExprConcat
-		15, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		81, // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S51
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		83, // ExprNotEquals
-		13, // ExprEquals
-		14, // ExprConcat
-		15, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		81, // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S52
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		84, // ExprEquals
-		14, // ExprConcat
-		15, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		81, // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S53
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		85, // ExprConcat
-		15, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		81, // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S54
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		86, // ExprLeaf
-		21, // Lambda
-		20, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		19, // Arg
-		81, // Var
-		16, // IfElse
-		17, // While
-	},
-	gotoRow{ // S55
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		90,  // Expr
-		92,  // ExprOr
-		93,  // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		102, // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		91,  // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S56
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		111, // Expr
-		113, // ExprOr
-		114, // ExprAnd
-		115, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		112, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S57
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S58
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		129, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S59
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		130, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S60
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		131, // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S61
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		132, // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S62
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S63
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		135, // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S64
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S65
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S66
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		137, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S67
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		140, // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		139, // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S68
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		141, // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		139, // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S69
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		142, // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		139, // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S70
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		143, // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		139, // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S71
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		144, // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		139, // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S72
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		90,  // Expr
-		92,  // ExprOr
-		93,  // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		145, // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		91,  // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S73
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		146, // Expr
-		113, // ExprOr
-		114, // ExprAnd
-		115, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		112, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S74
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S75
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		148, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S76
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		149, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S77
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S78
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		150, // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S79
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S80
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S81
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S82
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S83
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S84
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S85
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S86
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S87
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S88
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S89
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		152, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S90
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		154, // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S91
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S92
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S93
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S94
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S95
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S96
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S97
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S98
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S99
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S100
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S101
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S102
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S103
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S104
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S105
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S106
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S107
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S108
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S109
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S110
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		168, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S111
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S112
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S113
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S114
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S115
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S116
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S117
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S118
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S119
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S120
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S121
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S122
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S123
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S124
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S125
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S126
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S127
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S128
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S129
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S130
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S131
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S132
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S133
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S134
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S135
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S136
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S137
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S138
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S139
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S140
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S141
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S142
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S143
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S144
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S145
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S146
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S147
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S148
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S149
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S150
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S151
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		193, // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S152
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S153
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		195, // Expr
-		92,  // ExprOr
-		93,  // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		91,  // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S154
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S155
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		196, // Expr
-		92,  // ExprOr
-		93,  // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		91,  // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S156
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		199, // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		198, // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S157
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		200, // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		198, // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S158
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		201, // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		198, // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S159
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		202, // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		198, // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S160
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		203, // ExprLeaf
-		104, // Lambda
-		103, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		198, // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S161
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		90,  // Expr
-		92,  // ExprOr
-		93,  // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		204, // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		91,  // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S162
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		205, // Expr
-		113, // ExprOr
-		114, // ExprAnd
-		115, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		112, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S163
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S164
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S165
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		207, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S166
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		208, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S167
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		209, // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S168
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S169
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S170
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		211, // Expr
-		113, // ExprOr
-		114, // ExprAnd
-		115, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		112, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S171
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		214, // ExprAnd
-		115, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		213, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S172
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		215, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		213, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S173
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		216, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		213, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S174
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		217, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		213, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S175
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		218, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		213, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S176
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		90,  // Expr
-		92,  // ExprOr
-		93,  // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		219, // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		91,  // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S177
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		220, // Expr
-		113, // ExprOr
-		114, // ExprAnd
-		115, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		112, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S178
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S179
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S180
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		222, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S181
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		223, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S182
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S183
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S184
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S185
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		227, // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S186
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		228, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S187
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S188
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S189
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S190
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S191
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S192
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S193
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S194
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S195
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		253, // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S196
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S197
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S198
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S199
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S200
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S201
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S202
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S203
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S204
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S205
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S206
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S207
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S208
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S209
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S210
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S211
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S212
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S213
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S214
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S215
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S216
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S217
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S218
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S219
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S220
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S221
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S222
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S223
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S224
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		265, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S225
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		266, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S226
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		267, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S227
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S228
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S229
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S230
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S231
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		270, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S232
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		271, // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S233
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S234
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S235
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S236
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S237
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S238
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S239
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S240
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S241
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S242
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S243
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S244
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S245
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S246
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S247
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S248
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S249
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		284, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S250
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		285, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S251
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		286, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S252
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S253
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S254
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S255
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S256
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S257
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S258
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S259
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S260
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S261
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S262
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S263
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S264
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S265
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S266
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S267
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S268
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S269
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		296, // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S270
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S271
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S272
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		298, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S273
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		299, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S274
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		302, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		301, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S275
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		303, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		301, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S276
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		304, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		301, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S277
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		305, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		301, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S278
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		306, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		301, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S279
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		90,  // Expr
-		92,  // ExprOr
-		93,  // ExprAnd
-		94,  // ExprNotEquals
-		95,  // ExprEquals
-		96,  // ExprConcat
-		97,  // ExprLeaf
-		104, // Lambda
-		103, // Index
-		307, // CallArgs
-		-1,  // CallArgsHelper
-		101, // Arg
-		91,  // Var
-		98,  // IfElse
-		99,  // While
-	},
-	gotoRow{ // S280
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		308, // Expr
-		113, // ExprOr
-		114, // ExprAnd
-		115, // ExprNotEquals
-		116, // ExprEquals
-		117, // ExprConcat
-		118, // ExprLeaf
-		124, // Lambda
-		123, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		122, // Arg
-		112, // Var
-		119, // IfElse
-		120, // While
-	},
-	gotoRow{ // S281
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S282
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		310, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S283
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		311, // Expr
-		32,  // ExprOr
-		33,  // ExprAnd
-		34,  // ExprNotEquals
-		35,  // ExprEquals
-		36,  // ExprConcat
-		37,  // ExprLeaf
-		43,  // Lambda
-		42,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		41,  // Arg
-		31,  // Var
-		38,  // IfElse
-		39,  // While
-	},
-	gotoRow{ // S284
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S285
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S286
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S287
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		315, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S288
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		316, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S289
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		317, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S290
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		318, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S291
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		319, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S292
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		320, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S293
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S294
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S295
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S296
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S297
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S298
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		323, // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		-1,  // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S299
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S300
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S301
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S302
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S303
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S304
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S305
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S306
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S307
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S308
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S309
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S310
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S311
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S312
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S313
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S314
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S315
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S316
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S317
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S318
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S319
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S320
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S321
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		337, // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S322
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S323
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S324
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S325
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S326
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S327
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S328
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S329
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		342, // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S330
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S331
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S332
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S333
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S334
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S335
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S336
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		345, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S337
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S338
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		346, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S339
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		347, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S340
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		348, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S341
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		349, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S342
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S343
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		351, // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S344
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		353, // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S345
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S346
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S347
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S348
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S349
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S350
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		359, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S351
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S352
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		360, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S353
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S354
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S355
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S356
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S357
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S358
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S359
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S360
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S361
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		-1,  // Block
-		-1,  // BlockHelper
-		-1,  // Expr
-		-1,  // ExprOr
-		-1,  // ExprAnd
-		-1,  // ExprNotEquals
-		-1,  // ExprEquals
-		-1,  // ExprConcat
-		-1,  // ExprLeaf
-		-1,  // Lambda
-		-1,  // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		-1,  // Arg
-		-1,  // Var
-		365, // IfElse
-		-1,  // While
-	},
-	gotoRow{ // S362
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S363
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S364
-		-1,  // S'
-		-1,  // Program
-		-1,  // FuncDecls
-		-1,  // FuncDecl
-		-1,  // FuncParams
-		-1,  // FuncParamsHelper
-		366, // Block
-		-1,  // BlockHelper
-		232, // Expr
-		234, // ExprOr
-		235, // ExprAnd
-		236, // ExprNotEquals
-		237, // ExprEquals
-		238, // ExprConcat
-		239, // ExprLeaf
-		245, // Lambda
-		244, // Index
-		-1,  // CallArgs
-		-1,  // CallArgsHelper
-		243, // Arg
-		233, // Var
-		240, // IfElse
-		241, // While
-	},
-	gotoRow{ // S365
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S366
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-	gotoRow{ // S367
-		-1, // S'
-		-1, // Program
-		-1, // FuncDecls
-		-1, // FuncDecl
-		-1, // FuncParams
-		-1, // FuncParamsHelper
-		-1, // Block
-		-1, // BlockHelper
-		-1, // Expr
-		-1, // ExprOr
-		-1, // ExprAnd
-		-1, // ExprNotEquals
-		-1, // ExprEquals
-		-1, // ExprConcat
-		-1, // ExprLeaf
-		-1, // Lambda
-		-1, // Index
-		-1, // CallArgs
-		-1, // CallArgsHelper
-		-1, // Arg
-		-1, // Var
-		-1, // IfElse
-		-1, // While
-	},
-}
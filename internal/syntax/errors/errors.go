@@ -1,5 +1,3 @@
-// Code generated by gocc; DO NOT EDIT.
-
 package errors
 
 import (
@@ -8,7 +6,7 @@ import (
 	"strings"
 	"unicode"
 
-	"github.com/skius/stringlang/internal/frontend/token"
+	"github.com/skius/stringlang/internal/syntax/token"
 )
 
 type ErrorSymbol interface {
@@ -0,0 +1,458 @@
+package syntax
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/skius/stringlang/ast"
+	parseErrors "github.com/skius/stringlang/internal/syntax/errors"
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+// ErrTooDeep is returned for programs nested deeper than Parse allows
+var ErrTooDeep = errors.New("program is nested too deeply")
+
+// Parse parses src, continuing after syntax errors like the ParseRecover method of the generated parser: the statement
+// containing an error is replaced by an ast.Bad, skipping the tokens up to the ";" or "}" ending the statement. It
+// returns the partial program along with all errors, the program is nil if parsing couldn't continue.
+//
+// Expressions are nested at most maxDepth deep, counting parentheses, Parse returns ErrTooDeep for deeper programs. A
+// negative maxDepth disables the limit. The limit bounds its recursion, the expression trees of the programs it returns are nested at least as deep.
+func Parse(src []byte, maxDepth int) (prog ast.Expr, errs []*parseErrors.Error, err error) {
+	p := &parser{s: Scanner{src: src, line: 1, column: 1}, maxDepth: maxDepth}
+	p.s.scan(&p.tok)
+	defer func() {
+		if r := recover(); r != nil {
+			a, ok := r.(abort)
+			if !ok {
+				panic(r)
+			}
+			prog, errs, err = nil, p.errs, a.err
+		}
+	}()
+	res := p.program()
+	if p.pending != nil {
+		p.fatal(p.pending)
+	}
+	return res, p.errs, nil
+}
+
+// parser is a recursive descent parser for the grammar in lang.bnf, using precedence climbing for binary operators.
+//
+// Errors list the tokens expected where they were found, like those of the generated parser. To know them, the
+// parser adds every token type it checks for to expected, which is reset whenever it moves to the next token.
+type parser struct {
+	s        Scanner
+	tok      token.Token // The next token
+	peeked   bool        // Whether the token after tok was scanned into next
+	next     token.Token
+	expected uint64 // Set of the token types checked for at tok
+	errs     []*parseErrors.Error
+	// pending is the error converting the literal before tok, which the generated parser reports once it accepts tok
+	pending *parseErrors.Error
+	// declOffset is the offset of the token where a function declaration may start
+	declOffset int
+	depth      int
+	maxDepth   int
+}
+
+// bailout unwinds the parser to the enclosing statement after a syntax error at pos
+type bailout struct {
+	pos ast.Pos
+}
+
+// abort unwinds the parser completely after an error it can't recover from, err is returned by Parse if non-nil
+type abort struct {
+	err error
+}
+
+func bit(typ token.Type) uint64 {
+	return 1 << uint(typ)
+}
+
+// Tokens starting expressions and binary operators
+var (
+	exprStart = bit(tokFun) | bit(tokID) | bit(tokLParen) | bit(tokString) | bit(tokArg) | bit(tokIf) | bit(tokWhile)
+	operators = bit(tokOr) | bit(tokAnd) | bit(tokNotEq) | bit(tokEq) | bit(tokPlus)
+)
+
+// advance moves to the next token, accepting tok
+func (p *parser) advance() {
+	if p.pending != nil {
+		p.fatal(p.pending)
+	}
+	p.skip()
+}
+
+// skip moves to the next token without accepting tok
+func (p *parser) skip() {
+	if p.peeked {
+		p.tok = p.next
+		p.peeked = false
+	} else {
+		p.s.scan(&p.tok)
+	}
+	p.expected = 0
+}
+
+// peek returns the token after tok
+func (p *parser) peek() *token.Token {
+	if !p.peeked {
+		p.s.scan(&p.next)
+		p.peeked = true
+	}
+	return &p.next
+}
+
+// at returns whether tok has type typ
+func (p *parser) at(typ token.Type) bool {
+	p.expected |= bit(typ)
+	return p.tok.Type == typ
+}
+
+// got accepts tok if it has type typ
+func (p *parser) got(typ token.Type) bool {
+	if !p.at(typ) {
+		return false
+	}
+	p.advance()
+	return true
+}
+
+// expect accepts tok, which must have type typ
+func (p *parser) expect(typ token.Type) token.Token {
+	if !p.at(typ) {
+		p.fail()
+	}
+	tok := p.tok
+	p.advance()
+	return tok
+}
+
+// fail reports a syntax error at tok
+func (p *parser) fail() {
+	tok := p.tok
+	err := &parseErrors.Error{ErrorToken: &tok}
+	for typ := token.Type(0); int(typ) < 64; typ++ {
+		if p.expected&bit(typ) != 0 {
+			err.ExpectedTokens = append(err.ExpectedTokens, token.TokMap.Id(typ))
+		}
+	}
+	p.errs = append(p.errs, err)
+	// The generated parser doesn't convert the literal if the token after it doesn't parse
+	p.pending = nil
+	panic(bailout{pos(&tok)})
+}
+
+// fatal reports err and stops parsing
+func (p *parser) fatal(err *parseErrors.Error) {
+	p.errs = append(p.errs, err)
+	panic(abort{})
+}
+
+// literal sets the error converting the literal before tok, if any
+func (p *parser) literal(err error) {
+	if err != nil {
+		tok := p.tok
+		p.pending = &parseErrors.Error{Err: err, ErrorToken: &tok}
+	}
+}
+
+// enter enters a nested expression, which must be left by decrementing depth
+func (p *parser) enter() {
+	p.depth++
+	if p.maxDepth >= 0 && p.depth > p.maxDepth {
+		panic(abort{ErrTooDeep})
+	}
+}
+
+func pos(tok *token.Token) ast.Pos {
+	return ast.Pos{Offset: tok.Pos.Offset, Line: tok.Pos.Line, Column: tok.Pos.Column}
+}
+
+// try runs parse, returning the position of the syntax error if it fails
+func (p *parser) try(parse func()) (errPos ast.Pos, failed bool) {
+	depth := p.depth
+	defer func() {
+		if r := recover(); r != nil {
+			b, ok := r.(bailout)
+			if !ok {
+				panic(r)
+			}
+			p.depth = depth
+			errPos, failed = b.pos, true
+		}
+	}()
+	parse()
+	return ast.Pos{}, false
+}
+
+func (p *parser) program() ast.Program {
+	prog := ast.Program{Funcs: []ast.FuncDecl{}}
+	p.declOffset = p.tok.Pos.Offset
+	for p.at(tokFun) && p.peek().Type == tokID {
+		var f ast.FuncDecl
+		if errPos, failed := p.try(func() { f = p.funcDecl() }); failed {
+			// The declaration is parsed as the first statement instead
+			prog.Code = p.stmts(ast.Block{p.bad(errPos, true)}, true)
+			return prog
+		}
+		prog.Funcs = append(prog.Funcs, f)
+		p.declOffset = p.tok.Pos.Offset
+	}
+	if p.at(tokEOF) {
+		prog.Code = ast.Block([]ast.Expr{})
+		return prog
+	}
+	prog.Code = p.stmts(ast.Block{p.stmt(true)}, true)
+	return prog
+}
+
+func (p *parser) funcDecl() ast.FuncDecl {
+	fun := p.expect(tokFun)
+	name := p.expect(tokID)
+	params := p.params()
+	return ast.FuncDecl{Params: params, Code: p.body(), Identifier: string(name.Lit), Pos: pos(&fun)}
+}
+
+func (p *parser) params() []string {
+	p.expect(tokLParen)
+	params := []string{}
+	if p.at(tokID) {
+		for {
+			name := p.expect(tokID)
+			params = append(params, string(name.Lit))
+			if !p.got(tokComma) {
+				break
+			}
+		}
+	}
+	p.expect(tokRParen)
+	return params
+}
+
+// body parses a block in braces
+func (p *parser) body() ast.Block {
+	p.expect(tokLBrace)
+	b := p.stmts(ast.Block{p.stmt(false)}, false)
+	p.expect(tokRBrace)
+	return b
+}
+
+// stmts parses the statements following those of b, which is the code of the program if top is set
+func (p *parser) stmts(b ast.Block, top bool) ast.Block {
+	for p.got(tokSemi) {
+		b = append(b, p.stmt(top))
+	}
+	return b
+}
+
+// stmt parses a statement, which is followed by ";" or the end of its block
+func (p *parser) stmt(top bool) ast.Expr {
+	var e ast.Expr
+	errPos, failed := p.try(func() {
+		e = p.expr()
+		if !p.atStmtEnd(top) {
+			p.fail()
+		}
+	})
+	if failed {
+		return p.bad(errPos, top)
+	}
+	return e
+}
+
+func (p *parser) atStmtEnd(top bool) bool {
+	return p.at(tokSemi) || top && p.at(tokEOF) || !top && p.at(tokRBrace)
+}
+
+// bad skips the rest of a statement with a syntax error at errPos, the "}" of blocks within it don't end it. If the
+// source code ends first, the enclosing statement is skipped as well, unless the statement is at the top level.
+func (p *parser) bad(errPos ast.Pos, top bool) ast.Expr {
+	depth := 0
+	for {
+		switch p.tok.Type {
+		case tokEOF:
+			if !top {
+				panic(bailout{errPos})
+			}
+			return ast.Bad{Pos: errPos}
+		case tokSemi:
+			if depth == 0 {
+				return ast.Bad{Pos: errPos}
+			}
+		case tokLBrace:
+			depth++
+		case tokRBrace:
+			if depth == 0 && !top {
+				return ast.Bad{Pos: errPos}
+			}
+			if depth > 0 {
+				depth--
+			}
+		}
+		p.skip()
+	}
+}
+
+func (p *parser) expr() ast.Expr {
+	p.enter()
+	var e ast.Expr
+	if p.tok.Type == tokID {
+		// A variable, which is assigned to or starts an operand
+		id := p.tok
+		p.advance()
+		if p.tok.Type == tokAssign {
+			eq := p.tok
+			p.advance()
+			e = ast.Assn{V: ast.Var(id.Lit), E: p.expr(), Pos: pos(&eq)}
+		} else {
+			p.expected |= bit(tokAssign)
+			e = p.binary(p.postfix(ast.Var(id.Lit)), ast.OrOp)
+		}
+	} else {
+		e = p.binary(p.postfix(p.operand()), ast.OrOp)
+	}
+	p.depth--
+	return e
+}
+
+// binary parses the binary operators following lhs which bind at least as tightly as min. Ops are encoded as their
+// precedence.
+func (p *parser) binary(lhs ast.Expr, min ast.Op) ast.Expr {
+	for {
+		p.expected |= operators
+		var op ast.Op
+		switch p.tok.Type {
+		case tokOr:
+			op = ast.OrOp
+		case tokAnd:
+			op = ast.AndOp
+		case tokNotEq:
+			op = ast.NotEqualsOp
+		case tokEq:
+			op = ast.EqualsOp
+		case tokPlus:
+			op = ast.ConcatOp
+		}
+		if op == 0 || op < min {
+			return lhs
+		}
+		opTok := p.tok
+		p.advance()
+		rhs := p.binary(p.postfix(p.operand()), op+1)
+		lhs = ast.BinOp{Lhs: lhs, Rhs: rhs, Op: op, Pos: pos(&opTok)}
+	}
+}
+
+// postfix parses the calls and indexes following e
+func (p *parser) postfix(e ast.Expr) ast.Expr {
+	for {
+		switch {
+		case p.at(tokLParen):
+			open := p.tok
+			p.advance()
+			e = ast.Call{Fn: e, Args: p.args(), Pos: pos(&open)}
+		case p.at(tokLBrack):
+			open := p.tok
+			p.advance()
+			var i ast.Expr
+			if p.at(tokInt) {
+				i = ast.Val(p.tok.Lit)
+				p.advance()
+			} else {
+				i = p.expr()
+			}
+			p.expect(tokRBrack)
+			e = ast.Index{Source: e, I: i, Pos: pos(&open)}
+		default:
+			return e
+		}
+	}
+}
+
+// args parses the arguments of a call after its "(", up to and including its ")"
+func (p *parser) args() ast.CallArgs {
+	args := ast.CallArgs{}
+	if p.got(tokRParen) {
+		return args
+	}
+	for {
+		args = append(args, p.expr())
+		if !p.got(tokComma) {
+			break
+		}
+	}
+	p.expect(tokRParen)
+	return args
+}
+
+// operand parses an expression which isn't followed by calls, indexes or operators
+func (p *parser) operand() ast.Expr {
+	switch p.tok.Type {
+	case tokID:
+		v := ast.Var(p.tok.Lit)
+		p.advance()
+		return v
+	case tokString:
+		lit := string(p.tok.Lit)
+		p.advance()
+		v, err := strconv.Unquote(lit)
+		p.literal(err)
+		return ast.Val(v)
+	case tokArg:
+		p.advance()
+		lit := p.expect(tokInt)
+		i, err := strconv.Atoi(string(lit.Lit))
+		p.literal(err)
+		return ast.Arg(i)
+	case tokLParen:
+		p.advance()
+		e := p.expr()
+		p.expect(tokRParen)
+		return e
+	case tokIf:
+		return p.ifElse()
+	case tokWhile:
+		while := p.tok
+		p.advance()
+		cond := p.cond()
+		return ast.While{Cond: cond, Body: p.body(), Pos: pos(&while)}
+	case tokFun:
+		fun := p.tok
+		p.advance()
+		if fun.Pos.Offset == p.declOffset {
+			// The start of a function declaration as well
+			p.expected |= bit(tokID)
+		}
+		params := p.params()
+		return ast.Lambda{Params: params, Code: p.body(), Pos: pos(&fun)}
+	}
+	p.expected |= exprStart
+	p.fail()
+	return nil
+}
+
+func (p *parser) ifElse() ast.Expr {
+	ifTok := p.expect(tokIf)
+	cond := p.cond()
+	then := p.body()
+	p.expect(tokElse)
+	var els ast.Expr
+	if p.at(tokIf) {
+		p.enter()
+		els = p.ifElse()
+		p.depth--
+	} else {
+		els = p.body()
+	}
+	return ast.IfElse{Cond: cond, Then: then, Else: els, Pos: pos(&ifTok)}
+}
+
+// cond parses the condition of an if or while, in parentheses
+func (p *parser) cond() ast.Expr {
+	p.expect(tokLParen)
+	e := p.expr()
+	p.expect(tokRParen)
+	return e
+}
@@ -0,0 +1,274 @@
+// Package syntax is the frontend of StringLang: a scanner and a recursive descent parser for the grammar in lang.bnf.
+// It replaced a parser generated by gocc, whose tokens and errors it keeps producing; its tests check it against
+// digests of the generated parser's output.
+package syntax
+
+import (
+	"unicode/utf8"
+
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+// Types of tokens
+var (
+	tokEOF    = token.EOF
+	tokFun    = token.TokMap.Type("fun")
+	tokID     = token.TokMap.Type("id")
+	tokLParen = token.TokMap.Type("(")
+	tokRParen = token.TokMap.Type(")")
+	tokLBrace = token.TokMap.Type("{")
+	tokRBrace = token.TokMap.Type("}")
+	tokComma  = token.TokMap.Type(",")
+	tokSemi   = token.TokMap.Type(";")
+	tokAssign = token.TokMap.Type("=")
+	tokOr     = token.TokMap.Type("||")
+	tokAnd    = token.TokMap.Type("&&")
+	tokNotEq  = token.TokMap.Type("!=")
+	tokEq     = token.TokMap.Type("==")
+	tokPlus   = token.TokMap.Type("+")
+	tokString = token.TokMap.Type("string_lit")
+	tokLBrack = token.TokMap.Type("[")
+	tokRBrack = token.TokMap.Type("]")
+	tokInt    = token.TokMap.Type("int_lit")
+	tokArg    = token.TokMap.Type("%")
+	tokIf     = token.TokMap.Type("if")
+	tokElse   = token.TokMap.Type("else")
+	tokWhile  = token.TokMap.Type("while")
+)
+
+var keywords = map[string]token.Type{
+	"fun":   tokFun,
+	"if":    tokIf,
+	"else":  tokElse,
+	"while": tokWhile,
+}
+
+// Scanner splits source code into tokens, skipping whitespace and comments
+type Scanner struct {
+	src    []byte
+	pos    int
+	line   int
+	column int
+}
+
+func NewScanner(src []byte) *Scanner {
+	return &Scanner{src: src, line: 1, column: 1}
+}
+
+// Scan returns the next token, or a token of type token.EOF at the end of the source code. Source code no token
+// starts with is returned as a token of type token.INVALID, as are strings and comments missing their end.
+func (s *Scanner) Scan() *token.Token {
+	tok := &token.Token{}
+	s.scan(tok)
+	return tok
+}
+
+// scan scans the next token into tok.
+//
+// Like the lexer gocc generated, a token which can't be completed includes the character that ended it, though the
+// position doesn't advance past that character.
+func (s *Scanner) scan(tok *token.Token) {
+	complete := s.skip()
+	tok.Pos = token.Pos{Offset: s.pos, Line: s.line, Column: s.column}
+	start := s.pos
+	switch {
+	case !complete:
+		// An unterminated comment
+		s.advance(len(s.src) - s.pos)
+		tok.Type = token.INVALID
+		tok.Lit = s.src[start:]
+		return
+	case s.pos >= len(s.src):
+		tok.Type = tokEOF
+		tok.Lit = []byte{}
+		return
+	}
+
+	c := s.src[s.pos]
+	switch {
+	case isAlpha(c):
+		for s.pos < len(s.src) && (isAlpha(s.src[s.pos]) || isDigit(s.src[s.pos])) {
+			s.advance(1)
+		}
+		tok.Type = tokID
+		if kw, ok := keywords[string(s.src[start:s.pos])]; ok {
+			tok.Type = kw
+		}
+	case isDigit(c):
+		for s.pos < len(s.src) && isDigit(s.src[s.pos]) {
+			s.advance(1)
+		}
+		tok.Type = tokInt
+	case c == '"':
+		s.advance(1)
+		tok.Type = s.str()
+	default:
+		tok.Type = s.punctuation(c)
+	}
+	tok.Lit = s.src[start:s.pos]
+}
+
+// skip skips whitespace and comments. It stops at the start of an unterminated comment, returning false.
+func (s *Scanner) skip() bool {
+	for s.pos < len(s.src) {
+		switch s.src[s.pos] {
+		case ' ', '\t', '\n', '\r':
+			s.advance(1)
+		case '/':
+			if s.pos+1 >= len(s.src) || s.src[s.pos+1] != '*' {
+				return true
+			}
+			end := commentEnd(s.src[s.pos+2:])
+			if end < 0 {
+				return false
+			}
+			s.advance(2 + end)
+		default:
+			return true
+		}
+	}
+	return true
+}
+
+// commentEnd returns the length of the rest of a comment after its "/*", including its "*/", or -1 if it is
+// unterminated
+func commentEnd(rest []byte) int {
+	for i := 0; i+1 < len(rest); i++ {
+		if rest[i] == '*' && rest[i+1] == '/' {
+			return i + 2
+		}
+	}
+	return -1
+}
+
+// str scans the rest of a string after its opening quote, returning its token type
+func (s *Scanner) str() token.Type {
+	for s.pos < len(s.src) {
+		r, size := utf8.DecodeRune(s.src[s.pos:])
+		switch {
+		case r == '"':
+			s.advance(size)
+			return tokString
+		case r == '\\':
+			s.advance(size)
+			if s.pos >= len(s.src) {
+				return token.INVALID
+			}
+			r, size = utf8.DecodeRune(s.src[s.pos:])
+			if !isStringChar(r) && r != '"' && r != '\\' {
+				s.pos += size
+				return token.INVALID
+			}
+			s.advance(size)
+		case isStringChar(r):
+			s.advance(size)
+		default:
+			s.pos += size
+			return token.INVALID
+		}
+	}
+	return token.INVALID
+}
+
+// punctuation scans the operator or delimiter starting with c, returning its token type
+func (s *Scanner) punctuation(c byte) token.Type {
+	var typ token.Type
+	switch c {
+	case '(':
+		typ = tokLParen
+	case ')':
+		typ = tokRParen
+	case '{':
+		typ = tokLBrace
+	case '}':
+		typ = tokRBrace
+	case ',':
+		typ = tokComma
+	case ';':
+		typ = tokSemi
+	case '+':
+		typ = tokPlus
+	case '[':
+		typ = tokLBrack
+	case ']':
+		typ = tokRBrack
+	case '%':
+		typ = tokArg
+	case '=':
+		s.advance(1)
+		if s.pos < len(s.src) && s.src[s.pos] == '=' {
+			s.advance(1)
+			return tokEq
+		}
+		return tokAssign
+	case '|':
+		return s.pair('|', tokOr)
+	case '&':
+		return s.pair('&', tokAnd)
+	case '!':
+		return s.pair('=', tokNotEq)
+	case '/':
+		// Not the start of a comment, which skip skipped
+		return s.pair('*', token.INVALID)
+	default:
+		_, size := utf8.DecodeRune(s.src[s.pos:])
+		s.pos += size
+		return token.INVALID
+	}
+	s.advance(1)
+	return typ
+}
+
+// pair scans the rest of a token of two characters, the second of which is second
+func (s *Scanner) pair(second byte, typ token.Type) token.Type {
+	s.advance(1)
+	switch {
+	case s.pos >= len(s.src):
+		return token.INVALID
+	case s.src[s.pos] == second:
+		s.advance(1)
+		return typ
+	}
+	_, size := utf8.DecodeRune(s.src[s.pos:])
+	s.pos += size
+	return token.INVALID
+}
+
+// advance moves past the next n bytes, which are whole characters
+func (s *Scanner) advance(n int) {
+	end := s.pos + n
+	for s.pos < end {
+		c := s.src[s.pos]
+		if c >= utf8.RuneSelf {
+			_, size := utf8.DecodeRune(s.src[s.pos:end])
+			s.pos += size
+			s.column++
+			continue
+		}
+		switch c {
+		case '\n':
+			s.line++
+			s.column = 1
+		case '\r':
+			s.column = 1
+		case '\t':
+			s.column += 4
+		default:
+			s.column++
+		}
+		s.pos++
+	}
+}
+
+func isAlpha(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// isStringChar returns whether r may appear in a string unescaped
+func isStringChar(r rune) bool {
+	return r != 0 && r != '"' && r != '\\' && r != utf8.RuneError
+}
@@ -0,0 +1,454 @@
+package syntax
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang/ast"
+	parseErrors "github.com/skius/stringlang/internal/syntax/errors"
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+// Snippets for the corpus besides the example programs, including the pitfalls the generator doesn't produce
+var snippets = []string{
+	``,
+	`x = ;`,
+	`x = "a";`,
+	`fun f() { "a"; } f()`,
+	`fun f() { } f()`,
+	`if (x) { "a" }`,
+	`x = "a" + y = "b"`,
+	`x = "abc`,
+	`x = #`,
+	`fun (`,
+	`fun f(x y) { x }; z`,
+	`x = f(a,)`,
+	`x = a[`,
+	`x = a[1 + 2]`,
+	`} } x = "a"`,
+	`x = ) {`,
+	`fun f() {"a"} x = ) {`,
+	`a; x = ) { { }`,
+	`x = %99999999999999999999999`,
+	`x = %99999999999999999999999 #`,
+	`x = "\q"`,
+	`x = "\q" + "a"`,
+	`x = "\q" #`,
+	"x = \"a\nb\"",
+	`fun f(x) { y = ; z = x } fun g() { "g" } f("a"); w = ) ; v`,
+	`while (x) { if (y) { z = } else { w }; q } ; r = "r"`,
+	"fun f() { x = \n",
+	`a = "x"; fun() { b = `,
+	`while (c) { a; if (x) { b = `,
+	`fun() { "a" }`,
+	`fun f() {"a"} fun`,
+	`fun f() {"a"} fun + `,
+	`x = fun(a, b) { a + b }("1", "2")`,
+	`if (a) { b } else if (c) { d } else { e }("x")[0]`,
+	`a == b != c || d && e + f`,
+	`/* unterminated`,
+	`"a" /`,
+	`a != b ! c`,
+	`a | b & c`,
+	"a\t=\t\"\t\" /* \n */ + \"\xff\"",
+	"/* \xff\xfe */ a",
+	"a = \"\xef\xbf\xbd\"",
+	"a = \"\x00\"",
+	`a = "\"\\"`,
+	`a = "\`,
+}
+
+// corpus returns the programs the tests compare the parsers on: the example programs, the snippets, random programs
+// and mutations of all of them
+func corpus(t testing.TB, n int) []string {
+	var progs []string
+	files, err := filepath.Glob("../../stringlang_programs/*.stringlang")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		progs = append(progs, string(src))
+	}
+	progs = append(progs, snippets...)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < n; i++ {
+		g := &generator{r: r}
+		progs = append(progs, g.program())
+	}
+	for _, prog := range progs[:len(progs):len(progs)] {
+		for i := 0; i < 4; i++ {
+			progs = append(progs, mutate(r, prog))
+		}
+	}
+	return progs
+}
+
+// generator writes random programs following the grammar
+type generator struct {
+	r     *rand.Rand
+	b     strings.Builder
+	depth int
+}
+
+func (g *generator) program() string {
+	for i := g.r.Intn(3); i > 0; i-- {
+		g.write("fun", g.name(), "(")
+		g.params()
+		g.write(")")
+		g.block()
+	}
+	if g.r.Intn(8) > 0 {
+		g.stmts()
+	}
+	return g.b.String()
+}
+
+// write writes the tokens separated by random whitespace and comments
+func (g *generator) write(toks ...string) {
+	for _, tok := range toks {
+		switch g.r.Intn(12) {
+		case 0:
+			g.b.WriteString("\n\t")
+		case 1:
+			g.b.WriteString(" /* comment\n * ∑ */ ")
+		case 2, 3:
+			// No whitespace, unless the tokens would merge
+			if s := g.b.String(); s != "" && isWord(s[len(s)-1]) && isWord(tok[0]) {
+				g.b.WriteString(" ")
+			}
+		default:
+			g.b.WriteString(" ")
+		}
+		g.b.WriteString(tok)
+	}
+}
+
+func isWord(c byte) bool {
+	return isAlpha(c) || isDigit(c)
+}
+
+func (g *generator) name() string {
+	return []string{"a", "b", "x1", "_y", "length", "iff", "fun_", "elsewhere"}[g.r.Intn(8)]
+}
+
+func (g *generator) params() {
+	for i := g.r.Intn(3); i > 0; i-- {
+		g.write(g.name())
+		if i > 1 {
+			g.write(",")
+		}
+	}
+}
+
+func (g *generator) block() {
+	g.write("{")
+	g.stmts()
+	g.write("}")
+}
+
+func (g *generator) stmts() {
+	for i := 1 + g.r.Intn(3); i > 0; i-- {
+		if g.r.Intn(3) == 0 {
+			g.write(g.name(), "=")
+		}
+		g.expr()
+		if i > 1 {
+			g.write(";")
+		}
+	}
+}
+
+func (g *generator) expr() {
+	g.depth++
+	defer func() { g.depth-- }()
+	choice := g.r.Intn(14)
+	if g.depth > 4 {
+		choice = g.r.Intn(3)
+	}
+	switch choice {
+	case 0:
+		g.write(strconv.Quote([]string{"", "a", "ß\t", "\"q\"", "\\", "\n"}[g.r.Intn(6)]))
+	case 1:
+		g.write(g.name())
+	case 2:
+		g.write("%", strconv.Itoa(g.r.Intn(12)))
+	case 3:
+		// Assignments within expressions need parentheses
+		g.write("(", g.name(), "=")
+		g.expr()
+		g.write(")")
+	case 4, 5:
+		g.expr()
+		g.write([]string{"||", "&&", "!=", "==", "+"}[g.r.Intn(5)])
+		g.expr()
+	case 6:
+		g.expr()
+		g.write("(")
+		for i := g.r.Intn(3); i > 0; i-- {
+			g.expr()
+			if i > 1 {
+				g.write(",")
+			}
+		}
+		g.write(")")
+	case 7:
+		g.expr()
+		g.write("[")
+		if g.r.Intn(2) == 0 {
+			g.write(strconv.Itoa(g.r.Intn(5)))
+		} else {
+			g.expr()
+		}
+		g.write("]")
+	case 8, 9:
+		g.write("(")
+		g.expr()
+		g.write(")")
+	case 10, 11:
+		g.ifElse()
+	case 12:
+		g.write("while", "(")
+		g.expr()
+		g.write(")")
+		g.block()
+	case 13:
+		g.write("fun", "(")
+		g.params()
+		g.write(")")
+		g.block()
+	}
+}
+
+func (g *generator) ifElse() {
+	g.write("if", "(")
+	g.expr()
+	g.write(")")
+	g.block()
+	g.write("else")
+	if g.r.Intn(3) == 0 {
+		g.ifElse()
+	} else {
+		g.block()
+	}
+}
+
+// Text mutations insert, most of them tokens
+var insertions = []string{";", "}", "{", "(", ")", "=", ",", "[", "]", "fun", "if", "else", "%", "1", "x", `"s"`, "||",
+	"#", "!", "/", "/*", "*/", `"`, `\`, "\n", "\xff"}
+
+// mutate returns src changed randomly, usually such that it doesn't parse
+func mutate(r *rand.Rand, src string) string {
+	var offsets []int
+	s := NewScanner([]byte(src))
+	for tok := s.Scan(); tok.Type != token.EOF; tok = s.Scan() {
+		offsets = append(offsets, tok.Pos.Offset)
+	}
+	offsets = append(offsets, len(src))
+	at := offsets[r.Intn(len(offsets))]
+	switch r.Intn(5) {
+	case 0:
+		// Delete a token
+		i := r.Intn(len(offsets))
+		if i+1 < len(offsets) {
+			return src[:offsets[i]] + src[offsets[i+1]:]
+		}
+		return src
+	case 1:
+		return src[:at]
+	case 2:
+		if len(src) == 0 {
+			return src
+		}
+		i := r.Intn(len(src))
+		return src[:i] + string(rune(r.Intn(128))) + src[i+1:]
+	}
+	return src[:at] + insertions[r.Intn(len(insertions))] + src[at:]
+}
+
+// The golden file holds digests of the output of the gocc generated scanner and parser the hand-written ones replaced,
+// one line per program of corpus(t, goldenPrograms): of the tokens, of the JSON encoding of the program and of the
+// errors. Changing the corpus, the generator or the JSON encoding invalidates it; after an intended change to the
+// syntax, run the tests with -update and review the programs whose output changed.
+const (
+	goldenFile     = "testdata/gocc.golden"
+	goldenPrograms = 500
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// digests are the digests of scanning and of parsing a program
+type digests struct {
+	tokens, prog, errs string
+}
+
+func (d digests) String() string {
+	return d.tokens + " " + d.prog + " " + d.errs
+}
+
+func digest(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:8])
+}
+
+// dumpTokens lists the tokens of src up to and including EOF
+func dumpTokens(src string) string {
+	var b strings.Builder
+	s := NewScanner([]byte(src))
+	for {
+		tok := s.Scan()
+		fmt.Fprintf(&b, "%d %d:%d:%d %q\n", tok.Type, tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column, tok.Lit)
+		if tok.Type == token.EOF {
+			return b.String()
+		}
+	}
+}
+
+// dumpErrors lists the token, and the message or the expected tokens, of each error
+func dumpErrors(errs []*parseErrors.Error) string {
+	var b strings.Builder
+	for _, err := range errs {
+		tok := err.ErrorToken
+		fmt.Fprintf(&b, "%d %d:%d:%d", tok.Type, tok.Pos.Offset, tok.Pos.Line, tok.Pos.Column)
+		if err.Err != nil {
+			fmt.Fprintf(&b, " %q\n", err.Err.Error())
+		} else {
+			fmt.Fprintf(&b, " %q\n", err.ExpectedTokens)
+		}
+	}
+	return b.String()
+}
+
+// dumpProgram encodes prog as JSON, which unlike its Go syntax doesn't change with the layout of the structures of ast
+func dumpProgram(t *testing.T, prog ast.Expr) string {
+	t.Helper()
+	if prog == nil {
+		return "null"
+	}
+	data, err := ast.EncodeJSON(prog)
+	if err != nil {
+		t.Fatalf("encoding %#v: %v", prog, err)
+	}
+	return string(data)
+}
+
+// parse parses src, failing the test if it is too deep
+func parse(t *testing.T, src string) (ast.Expr, []*parseErrors.Error) {
+	t.Helper()
+	prog, errs, err := Parse([]byte(src), 1000)
+	if err != nil {
+		t.Fatalf("parsing %q: %v", src, err)
+	}
+	return prog, errs
+}
+
+// golden returns the corpus with the digests of the golden file, which it first rewrites if the update flag is set
+func golden(t *testing.T) ([]string, []digests) {
+	t.Helper()
+	progs := corpus(t, goldenPrograms)
+	if *update {
+		var b strings.Builder
+		for _, src := range progs {
+			prog, errs := parse(t, src)
+			d := digests{digest(dumpTokens(src)), digest(dumpProgram(t, prog)), digest(dumpErrors(errs))}
+			fmt.Fprintln(&b, d)
+		}
+		if err := ioutil.WriteFile(goldenFile, []byte(b.String()), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	data, err := ioutil.ReadFile(goldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	if len(lines) != len(progs) {
+		t.Fatalf("%s has %d lines for %d programs, the corpus changed", goldenFile, len(lines), len(progs))
+	}
+	want := make([]digests, len(lines))
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			t.Fatalf("%s:%d: malformed line %q", goldenFile, i+1, line)
+		}
+		want[i] = digests{fields[0], fields[1], fields[2]}
+	}
+	return progs, want
+}
+
+func TestScanner(t *testing.T) {
+	progs, want := golden(t)
+	for i, src := range progs {
+		if got := dumpTokens(src); digest(got) != want[i].tokens {
+			t.Errorf("scanning %q (%s:%d) changed, got\n%s", src, goldenFile, i+1, got)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	progs, want := golden(t)
+	for i, src := range progs {
+		prog, errs := parse(t, src)
+		if got := dumpProgram(t, prog); digest(got) != want[i].prog {
+			t.Errorf("parsing %q (%s:%d) changed, got\n%s", src, goldenFile, i+1, got)
+		}
+		if got := dumpErrors(errs); digest(got) != want[i].errs {
+			t.Errorf("the errors of parsing %q (%s:%d) changed, got\n%s", src, goldenFile, i+1, got)
+		}
+	}
+}
+
+func TestParseDepth(t *testing.T) {
+	deep := strings.Repeat("(", 100) + `"a"` + strings.Repeat(")", 100)
+	if _, _, err := Parse([]byte(deep), 50); err != ErrTooDeep {
+		t.Errorf("got %v, want ErrTooDeep", err)
+	}
+	if prog, _, err := Parse([]byte(deep), 200); err != nil || prog == nil {
+		t.Errorf("got %v, %v, want the program", prog, err)
+	}
+}
+
+// benchmarkSource is a large random program
+func benchmarkSource() []byte {
+	g := &generator{r: rand.New(rand.NewSource(1))}
+	for i := 0; i < 300; i++ {
+		if i > 0 {
+			g.write(";")
+		}
+		g.stmts()
+	}
+	return []byte(g.b.String())
+}
+
+func BenchmarkParse(b *testing.B) {
+	for _, bench := range []struct {
+		name string
+		src  []byte
+	}{
+		{"program", benchmarkSource()},
+		// Like the source of lambdas, which is parsed when they are called
+		{"lambda", []byte(`fun(x, y) { if (x == "") { y } else { x + ", " + y } }`)},
+	} {
+		src := bench.src
+		b.Run(bench.name, func(b *testing.B) {
+			b.SetBytes(int64(len(src)))
+			for i := 0; i < b.N; i++ {
+				if _, errs, _ := Parse(src, 1000); errs != nil {
+					b.Fatal(errs[0])
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,3 @@
-// Code generated by gocc; DO NOT EDIT.
-
 package token
 
 // Context allows user-defined data to be associated with the
@@ -1,5 +1,3 @@
-// Code generated by gocc; DO NOT EDIT.
-
 package token
 
 import (
@@ -147,6 +145,7 @@ var TokMap = TokenMap{
 		"}",
 		",",
 		";",
+		"error",
 		"=",
 		"||",
 		"&&",
@@ -175,19 +174,20 @@ var TokMap = TokenMap{
 		"}":          8,
 		",":          9,
 		";":          10,
-		"=":          11,
-		"||":         12,
-		"&&":         13,
-		"!=":         14,
-		"==":         15,
-		"+":          16,
-		"string_lit": 17,
-		"[":          18,
-		"]":          19,
-		"int_lit":    20,
-		"%":          21,
-		"if":         22,
-		"else":       23,
-		"while":      24,
+		"error":      11,
+		"=":          12,
+		"||":         13,
+		"&&":         14,
+		"!=":         15,
+		"==":         16,
+		"+":          17,
+		"string_lit": 18,
+		"[":          19,
+		"]":          20,
+		"int_lit":    21,
+		"%":          22,
+		"if":         23,
+		"else":       24,
+		"while":      25,
 	},
 }
@@ -0,0 +1,238 @@
+// Package lint finds likely mistakes in StringLang programs. Checks are implemented as Rules in a registry, which
+// programs can suppress using comments:
+//
+//	/* lint:ignore rule1,rule2 */      suppresses findings on the line of the comment and the line after it
+//	/* lint:file-ignore rule1,rule2 */ suppresses findings in the whole program
+//	/* lint:args n */                  declares that the program expects n arguments, %0 to %(n-1)
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/diag"
+	"github.com/skius/stringlang/internal/syntax"
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+// Diagnostic is a finding of a Rule
+type Diagnostic struct {
+	Rule    string
+	Pos     ast.Pos
+	End     int // Offset of the end of the reported source code, the token at Pos
+	Message string
+	Fix     *diag.Fix // Suggested change of the source code, if any
+}
+
+func (d Diagnostic) String() string {
+	return d.Pos.String() + ": " + d.Message + " (" + d.Rule + ")"
+}
+
+// Diag returns d as a warning of the program src, which is the code of d
+func (d Diagnostic) Diag(src []byte) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Code:     d.Rule,
+		Message:  d.Message,
+		Span:     diag.Range(src, d.Pos.Offset, d.End),
+		Fix:      d.Fix,
+	}
+}
+
+// Rule checks programs for one kind of mistake
+type Rule struct {
+	Name string // Used to enable, disable and suppress the rule
+	Doc  string
+	Run  func(p *Pass)
+}
+
+var registry = make(map[string]*Rule)
+
+// Register adds r to the rules Lint runs by default. It panics if a rule with the same name exists.
+func Register(r *Rule) {
+	if _, ok := registry[r.Name]; ok {
+		panic("lint: rule " + r.Name + " registered twice")
+	}
+	registry[r.Name] = r
+}
+
+// Rules returns all registered rules sorted by name
+func Rules() []*Rule {
+	rules := make([]*Rule, 0, len(registry))
+	for _, r := range registry {
+		rules = append(rules, r)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	return rules
+}
+
+// Config selects the rules Lint runs and describes the host of the program
+type Config struct {
+	Enable  []string // Names of the rules to run, all registered ones if empty
+	Disable []string // Names of rules not to run
+	// Builtins are the built-in functions the program can call, e.g. ast.Context.FunctionMap
+	Builtins map[string]ast.Builtin
+	// Args is the number of arguments the program expects, unless the program declares it using lint:args. Zero
+	// means unknown.
+	Args int
+}
+
+// Pass is a single rule checking a single program
+type Pass struct {
+	Prog   ast.Program
+	Src    []byte
+	Config *Config
+	// Args is the number of arguments the program expects, zero if unknown
+	Args int
+
+	rule   *Rule
+	tokens []*token.Token
+	diags  *[]Diagnostic
+}
+
+// Report records a finding at pos
+func (p *Pass) Report(pos ast.Pos, format string, args ...interface{}) {
+	p.ReportFix(pos, nil, format, args...)
+}
+
+// ReportFix records a finding at pos, which fix resolves. The spans of fix are in Src, see diag.Range.
+func (p *Pass) ReportFix(pos ast.Pos, fix *diag.Fix, format string, args ...interface{}) {
+	end := pos.Offset
+	if i := p.tokenAt(pos.Offset); i >= 0 {
+		end += len(p.tokens[i].Lit)
+	}
+	*p.diags = append(*p.diags, Diagnostic{
+		Rule:    p.rule.Name,
+		Pos:     pos,
+		End:     end,
+		Message: fmt.Sprintf(format, args...),
+		Fix:     fix,
+	})
+}
+
+// tokenAt returns the index of the token starting at offset, or -1 if there is none
+func (p *Pass) tokenAt(offset int) int {
+	i := sort.Search(len(p.tokens), func(i int) bool { return p.tokens[i].Pos.Offset >= offset })
+	if i < len(p.tokens) && p.tokens[i].Pos.Offset == offset {
+		return i
+	}
+	return -1
+}
+
+// Builtin returns the built-in function name of the host, if there is one
+func (p *Pass) Builtin(name string) (ast.Builtin, bool) {
+	b, ok := p.Config.Builtins[name]
+	return b, ok
+}
+
+// ErrUnknownRule is returned for rule names no registered rule has
+var ErrUnknownRule = errors.New("unknown lint rule")
+
+var directive = regexp.MustCompile(`^/\*\s*lint:(ignore|file-ignore|args)\s+([\w,-]+)\s*\*/$`)
+
+// Lint parses src and checks it using the rules cfg selects. The diagnostics are sorted by position, those
+// suppressed by comments are left out. If src doesn't parse, Lint returns all its stringlang.SyntaxErrors.
+func Lint(src []byte, cfg Config) ([]Diagnostic, error) {
+	rules, err := selectRules(cfg)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := stringlang.ParseRecover(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []*token.Token
+	l := syntax.NewScanner(src)
+	for tok := l.Scan(); tok.Type != token.EOF; tok = l.Scan() {
+		tokens = append(tokens, tok)
+	}
+
+	// Directives are comments, which only appear between tokens
+	ignoreLines := make(map[int]map[string]bool)
+	ignoreFile := make(map[string]bool)
+	args := cfg.Args
+	prevEnd := 0
+	for _, tok := range append(tokens, &token.Token{Pos: token.Pos{Offset: len(src)}}) {
+		gap := string(src[prevEnd:tok.Pos.Offset])
+		for start := strings.Index(gap, "/*"); start >= 0; start = strings.Index(gap, "/*") {
+			end := strings.Index(gap[start:], "*/")
+			if end < 0 {
+				break
+			}
+			end += start + 2
+			if m := directive.FindStringSubmatch(gap[start:end]); m != nil {
+				line := 1 + strings.Count(string(src[:prevEnd])+gap[:end], "\n")
+				switch m[1] {
+				case "ignore":
+					for _, l := range []int{line, line + 1} {
+						if ignoreLines[l] == nil {
+							ignoreLines[l] = make(map[string]bool)
+						}
+						for _, name := range strings.Split(m[2], ",") {
+							ignoreLines[l][name] = true
+						}
+					}
+				case "file-ignore":
+					for _, name := range strings.Split(m[2], ",") {
+						ignoreFile[name] = true
+					}
+				case "args":
+					if n, err := strconv.Atoi(m[2]); err == nil {
+						args = n
+					}
+				}
+			}
+			prevEnd += end
+			gap = gap[end:]
+		}
+		prevEnd = tok.Pos.Offset + len(tok.Lit)
+	}
+
+	var diags []Diagnostic
+	for _, r := range rules {
+		if ignoreFile[r.Name] {
+			continue
+		}
+		p := &Pass{Prog: expr.(ast.Program), Src: src, Config: &cfg, Args: args, rule: r, tokens: tokens}
+		var found []Diagnostic
+		p.diags = &found
+		r.Run(p)
+		for _, d := range found {
+			if !ignoreLines[d.Pos.Line][r.Name] {
+				diags = append(diags, d)
+			}
+		}
+	}
+	sort.SliceStable(diags, func(i, j int) bool {
+		return diags[i].Pos.Offset < diags[j].Pos.Offset
+	})
+	return diags, nil
+}
+
+func selectRules(cfg Config) ([]*Rule, error) {
+	for _, name := range append(append([]string(nil), cfg.Enable...), cfg.Disable...) {
+		if _, ok := registry[name]; !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownRule, name)
+		}
+	}
+	enabled := make(ast.Set)
+	if len(cfg.Enable) > 0 {
+		enabled.Add(cfg.Enable...)
+	}
+	disabled := ast.SetFrom(cfg.Disable...)
+
+	var rules []*Rule
+	for _, r := range Rules() {
+		if (len(enabled) == 0 || enabled.Contains(r.Name)) && !disabled.Contains(r.Name) {
+			rules = append(rules, r)
+		}
+	}
+	return rules, nil
+}
@@ -0,0 +1,132 @@
+package lint_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/lint"
+)
+
+var builtins = map[string]ast.Builtin{
+	"upper": ast.BuiltinFunc{Identifier: "upper", Min: 1, Max: 1, Fn: func(_ *ast.CallContext, args []string) (string, error) {
+		return strings.ToUpper(args[0]), nil
+	}},
+}
+
+// check lints src using cfg and returns its diagnostics as strings
+func check(t *testing.T, src string, cfg lint.Config) []string {
+	t.Helper()
+	cfg.Builtins = builtins
+	diags, err := lint.Lint([]byte(src), cfg)
+	if err != nil {
+		t.Fatalf("linting %q: %v", src, err)
+	}
+	res := []string{}
+	for _, d := range diags {
+		res = append(res, d.String())
+	}
+	return res
+}
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		rule string
+		src  string
+		want []string
+	}{
+		{"read-before-assign", `x + "a"`, []string{"1:1: x is read before it is assigned, relying on it starting out as \"\" (read-before-assign)"}},
+		{"read-before-assign", `x = "a"; x`, []string{}},
+		{"read-before-assign", `true`, []string{"1:1: true is read before it is assigned, true and false are variables rather than literals (read-before-assign)"}},
+		{"read-before-assign", `fun f(p) { p + q } q = "a"; f(q)`, []string{"1:16: q is read before it is assigned, relying on it starting out as \"\" (read-before-assign)"}},
+		{"read-before-assign", `fun f() { x() } x = "a"; x`, []string{}},
+		{"read-before-assign", `eval("x = \"a\""); x`, []string{}},
+		{"unused-assign", `x = "a"; y = "b"; y`, []string{"1:3: the value assigned to x is never read (unused-assign)"}},
+		{"unused-assign", `fun f() { x = "a"; "b" } f()`, []string{"1:13: the value assigned to x is never read (unused-assign)"}},
+		{"unused-assign", `x = "a"; eval("x")`, []string{}},
+		{"unknown-function", `missing("a")`, []string{"1:8: missing is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)"}},
+		{"unknown-function", `fun f() { "a" } l = fun() { "b" }; f() + l() + upper("c")`, []string{}},
+		{"unknown-function", `l = fun() { inner() }; l()`, []string{"1:18: inner is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)"}},
+		{"arity", `fun f(a) { a } f() + f("a") + f("a", "b")`, []string{"1:17: f takes 1 argument, but is called with 0 (arity)", "1:32: f takes 1 argument, but is called with 2 (arity)"}},
+		{"arity", `upper() + upper("a") + upper("a", "b")`, []string{"1:6: upper takes at least 1 argument, but is called with 0 (arity)", "1:29: upper takes at most 1 argument, but is called with 2 (arity)"}},
+		{"arity", `fun upper() { "" } upper()`, []string{}},
+		{"arg-range", `%0 + %1`, []string{}},
+		{"arg-range", `/* lint:args 1 */ %0 + %1`, []string{"1:24: %1 is beyond the 1 argument the program expects (arg-range)"}},
+		{"constant-condition", `if ("a") { "b" } else { "c" }`, []string{"1:1: the condition is always true, the else branch is unreachable (constant-condition)"}},
+		{"constant-condition", `if ("a" == "b") { "b" } else { "c" }`, []string{"1:1: the condition is always false, the then branch is unreachable (constant-condition)"}},
+		{"constant-condition", `while ("") { "b" }`, []string{"1:1: the condition is always false, the loop body is unreachable (constant-condition)"}},
+		{"constant-condition", `x = %0; if (x) { "b" } else { "c" }`, []string{}},
+	}
+	for _, tt := range tests {
+		got := check(t, tt.src, lint.Config{Enable: []string{tt.rule}})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s on %q: got %q, want %q", tt.rule, tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestDirectives(t *testing.T) {
+	tests := []struct {
+		src  string
+		want []string
+	}{
+		{"x = missing();\ny = missing()", []string{
+			"1:12: missing is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)",
+			"2:12: missing is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)",
+		}},
+		{"/* lint:ignore unknown-function */\nx = missing();\ny = missing()", []string{
+			"3:12: missing is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)",
+		}},
+		{"x = missing() /* lint:ignore unknown-function */;\ny = missing()", []string{}},
+		{"/* lint:ignore arity,unused-assign */\nx = missing();\ny = missing()", []string{
+			"2:12: missing is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)",
+			"3:12: missing is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)",
+		}},
+		{"x = missing();\n/* lint:file-ignore unknown-function */\ny = missing()", []string{}},
+		{"/* lint:args 2 */ %2", []string{"1:19: %2 is beyond the 2 arguments the program expects (arg-range)"}},
+		{"/* lint: args 2 */ %2", []string{}},
+		{`x = "/* lint:file-ignore unknown-function */"; missing()`, []string{
+			"1:55: missing is neither a function nor a variable, calling it parses \"\" as a lambda (unknown-function)",
+		}},
+	}
+	for _, tt := range tests {
+		got := check(t, tt.src, lint.Config{Enable: []string{"unknown-function", "arg-range"}})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%q: got %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestConfig(t *testing.T) {
+	const src = `x = "a"; %3`
+	if got := check(t, src, lint.Config{Disable: []string{"unused-assign"}}); len(got) != 0 {
+		t.Errorf("got %q with unused-assign disabled", got)
+	}
+	want := []string{"1:10: %3 is beyond the 2 arguments the program expects (arg-range)"}
+	if got := check(t, src, lint.Config{Enable: []string{"arg-range"}, Args: 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	for _, cfg := range []lint.Config{{Enable: []string{"missing"}}, {Disable: []string{"missing"}}} {
+		if _, err := lint.Lint([]byte(src), cfg); !errors.Is(err, lint.ErrUnknownRule) {
+			t.Errorf("got error %v, want %v", err, lint.ErrUnknownRule)
+		}
+	}
+	if _, err := lint.Lint([]byte(`x = (`), lint.Config{}); err == nil {
+		t.Error("linting a program with syntax errors: got no error")
+	}
+}
+
+func TestUnusedAssignFix(t *testing.T) {
+	src := []byte("x =  f(\"a\");\n\"b\"")
+	diags, err := lint.Lint(src, lint.Config{Enable: []string{"unused-assign"}})
+	if err != nil || len(diags) != 1 || diags[0].Fix == nil {
+		t.Fatalf("got %v, %v, want one diagnostic with a fix", diags, err)
+	}
+	edit := diags[0].Fix.Edits[0]
+	fixed := string(src[:edit.Span.Start.Offset]) + edit.NewText + string(src[edit.Span.End.Offset:])
+	if want := "f(\"a\");\n\"b\""; fixed != want {
+		t.Errorf("fixed source is %q, want %q", fixed, want)
+	}
+}
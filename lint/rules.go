@@ -0,0 +1,334 @@
+package lint
+
+import (
+	"context"
+
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/cfg"
+	"github.com/skius/stringlang/diag"
+	"github.com/skius/stringlang/internal/syntax/token"
+	"github.com/skius/stringlang/optimizer"
+	"github.com/skius/stringlang/optimizer/analysis/liveness"
+)
+
+func init() {
+	Register(&Rule{
+		Name: "read-before-assign",
+		Doc:  "Variables read before any assignment, which relies on them starting out as \"\"",
+		Run:  readBeforeAssign,
+	})
+	Register(&Rule{
+		Name: "unused-assign",
+		Doc:  "Assignments whose value is never read",
+		Run:  unusedAssign,
+	})
+	Register(&Rule{
+		Name: "unknown-function",
+		Doc:  "Calls of names that are neither functions nor variables, which parse the empty string as a lambda",
+		Run:  unknownFunction,
+	})
+	Register(&Rule{
+		Name: "arity",
+		Doc:  "Calls of functions with the wrong number of arguments",
+		Run:  arity,
+	})
+	Register(&Rule{
+		Name: "arg-range",
+		Doc:  "Program arguments beyond the number of arguments the program expects, see lint:args",
+		Run:  argRange,
+	})
+	Register(&Rule{
+		Name: "constant-condition",
+		Doc:  "Conditions that are always true or always false, making branches unreachable",
+		Run:  constantCondition,
+	})
+}
+
+func readBeforeAssign(p *Pass) {
+	if p.Prog.Info().UsesEval {
+		// eval may assign any variable
+		return
+	}
+	funcNames := p.funcNames()
+	for name := range p.Config.Builtins {
+		funcNames.Add(name)
+	}
+	check := func(code ast.Block, params ast.Set, from, to int) {
+		used := ast.UsedBeforeDefVars(code, funcNames).Except(params)
+		for _, name := range used.Sorted() {
+			// Names only ever called are left to unknown-function
+			pos := p.readPos(name, from, to)
+			if !pos.IsValid() {
+				continue
+			}
+			if name == "true" || name == "false" {
+				p.Report(pos, "%s is read before it is assigned, true and false are variables rather than literals", name)
+			} else {
+				p.Report(pos, "%s is read before it is assigned, relying on it starting out as \"\"", name)
+			}
+		}
+	}
+	for _, f := range p.Prog.Funcs {
+		check(f.Code, ast.SetFrom(f.Params...), f.Pos.Offset, p.funcEnd(f.Pos.Offset))
+	}
+	check(p.Prog.Code, ast.EmptySet(), p.codeStart(), len(p.Src))
+}
+
+func unusedAssign(p *Pass) {
+	if p.Prog.Info().UsesEval {
+		// eval may read any variable
+		return
+	}
+	// Liveness needs a normalized program, whose assignments keep their positions
+	norm := optimizer.Normalize(p.Prog)
+	blocks := []ast.Block{norm.Code}
+	for _, f := range norm.Funcs {
+		blocks = append(blocks, f.Code)
+	}
+	reported := make(map[int]bool)
+	for _, code := range blocks {
+		if len(code) == 0 {
+			continue
+		}
+		graph, _ := cfg.New(ast.Program{Code: code})
+		_, liveOut := liveness.Compute(graph)
+		graph.Visit(func(n *cfg.Node) {
+			a, ok := n.Expr.(ast.Assn)
+			if !ok || !a.Pos.IsValid() || reported[a.Pos.Offset] {
+				return
+			}
+			if _, live := liveOut[n.Label][string(a.V)]; !live {
+				reported[a.Pos.Offset] = true
+				p.ReportFix(a.Pos, p.removeAssign(a), "the value assigned to %s is never read", a.V)
+			}
+		})
+	}
+}
+
+func unknownFunction(p *Pass) {
+	funcNames := p.funcNames()
+	check := func(e ast.Expr, scope ast.Set) {
+		call, ok := e.(ast.Call)
+		if !ok {
+			return
+		}
+		fn, ok := call.Fn.(ast.Var)
+		if !ok || funcNames.Contains(string(fn)) || scope.Contains(string(fn)) {
+			return
+		}
+		if _, ok := p.Builtin(string(fn)); !ok {
+			p.Report(call.Pos, "%s is neither a function nor a variable, calling it parses \"\" as a lambda", fn)
+		}
+	}
+	for _, f := range p.Prog.Funcs {
+		inspect(f.Code, ast.SetFrom(f.Params...).Union(ast.DefinedVars(f.Code)), check)
+	}
+	inspect(p.Prog.Code, ast.DefinedVars(p.Prog.Code), check)
+}
+
+func arity(p *Pass) {
+	funcs := make(map[string]ast.FuncDecl, len(p.Prog.Funcs))
+	for _, f := range p.Prog.Funcs {
+		funcs[f.Identifier] = f
+	}
+	check := func(e ast.Expr, _ ast.Set) {
+		call, ok := e.(ast.Call)
+		if !ok {
+			return
+		}
+		fn, ok := call.Fn.(ast.Var)
+		if !ok {
+			return
+		}
+		// Calls prefer user-defined functions over built-in ones
+		if f, ok := funcs[string(fn)]; ok {
+			if len(call.Args) != len(f.Params) {
+				p.Report(call.Pos, "%s takes %s, but is called with %d", fn, ast.Arguments(len(f.Params)), len(call.Args))
+			}
+			return
+		}
+		b, ok := p.Builtin(string(fn))
+		if !ok {
+			return
+		}
+		switch n := len(call.Args); {
+		case n < b.MinArity():
+			p.Report(call.Pos, "%s takes at least %s, but is called with %d", fn, ast.Arguments(b.MinArity()), n)
+		case b.MaxArity() >= 0 && n > b.MaxArity():
+			p.Report(call.Pos, "%s takes at most %s, but is called with %d", fn, ast.Arguments(b.MaxArity()), n)
+		}
+	}
+	for _, f := range p.Prog.Funcs {
+		inspect(f.Code, nil, check)
+	}
+	inspect(p.Prog.Code, nil, check)
+}
+
+func argRange(p *Pass) {
+	if p.Args <= 0 {
+		return
+	}
+	for i := 0; i+1 < len(p.tokens); i++ {
+		tok, next := p.tokens[i], p.tokens[i+1]
+		if tok.Type != tokArg || next.Type != tokInt {
+			continue
+		}
+		if n, err := next.Int64Value(); err == nil && n >= int64(p.Args) {
+			p.Report(toPos(tok), "%%%d is beyond the %s the program expects", n, ast.Arguments(p.Args))
+		}
+	}
+}
+
+func constantCondition(p *Pass) {
+	check := func(e ast.Expr, _ ast.Set) {
+		switch e := e.(type) {
+		case ast.IfElse:
+			if v, ok := constant(e.Cond); ok && ast.BoolOf(v) {
+				p.Report(e.Pos, "the condition is always true, the else branch is unreachable")
+			} else if ok {
+				p.Report(e.Pos, "the condition is always false, the then branch is unreachable")
+			}
+		case ast.While:
+			if v, ok := constant(e.Cond); ok && ast.BoolOf(v) {
+				p.Report(e.Pos, "the condition is always true, the loop never ends")
+			} else if ok {
+				p.Report(e.Pos, "the condition is always false, the loop body is unreachable")
+			}
+		}
+	}
+	for _, f := range p.Prog.Funcs {
+		inspect(f.Code, nil, check)
+	}
+	inspect(p.Prog.Code, nil, check)
+}
+
+// constant returns the value of e if it only consists of values and operators
+func constant(e ast.Expr) (ast.Val, bool) {
+	var isConst func(e ast.Expr) bool
+	isConst = func(e ast.Expr) bool {
+		switch e := e.(type) {
+		case ast.Val:
+			return true
+		case ast.BinOp:
+			return isConst(e.Lhs) && isConst(e.Rhs)
+		}
+		return false
+	}
+	if !isConst(e) {
+		return "", false
+	}
+	v, err := ast.NewContext(nil, nil, nil).EvalContext(context.Background(), e)
+	return v, err == nil
+}
+
+// inspect calls f for e and every expression within it, along with the variables in scope there. Lambdas see the
+// variables of their scope, which they capture, as well as their own.
+func inspect(e ast.Expr, scope ast.Set, f func(e ast.Expr, scope ast.Set)) {
+	ast.Walk(scopeVisitor{scope: scope, f: f}, e)
+}
+
+type scopeVisitor struct {
+	scope ast.Set
+	f     func(e ast.Expr, scope ast.Set)
+}
+
+func (v scopeVisitor) Visit(e ast.Expr) ast.Visitor {
+	if e == nil {
+		return nil
+	}
+	v.f(e, v.scope)
+	if l, ok := e.(ast.Lambda); ok {
+		inner := v.scope.UnionCopy(ast.SetFrom(l.Params...)).Union(ast.DefinedVars(l.Code))
+		return scopeVisitor{scope: inner, f: v.f}
+	}
+	return v
+}
+
+var (
+	tokFun    = token.TokMap.Type("fun")
+	tokID     = token.TokMap.Type("id")
+	tokLParen = token.TokMap.Type("(")
+	tokLBrace = token.TokMap.Type("{")
+	tokRBrace = token.TokMap.Type("}")
+	tokAssign = token.TokMap.Type("=")
+	tokArg    = token.TokMap.Type("%")
+	tokInt    = token.TokMap.Type("int_lit")
+)
+
+func (p *Pass) funcNames() ast.Set {
+	names := make(ast.Set, len(p.Prog.Funcs))
+	for _, f := range p.Prog.Funcs {
+		names.Add(f.Identifier)
+	}
+	return names
+}
+
+// readPos returns the position of the first read of the variable name between the offsets from and to, i.e. of its
+// first identifier that is neither assigned nor called
+func (p *Pass) readPos(name string, from, to int) ast.Pos {
+	for i, tok := range p.tokens {
+		if tok.Pos.Offset >= to {
+			break
+		}
+		if tok.Pos.Offset < from || tok.Type != tokID || string(tok.Lit) != name {
+			continue
+		}
+		if i+1 < len(p.tokens) && (p.tokens[i+1].Type == tokAssign || p.tokens[i+1].Type == tokLParen) {
+			continue
+		}
+		if i > 0 && p.tokens[i-1].Type == tokFun {
+			continue
+		}
+		return toPos(tok)
+	}
+	return ast.Pos{}
+}
+
+// removeAssign returns the fix turning the assignment a into its expression, which keeps the value and side effects
+func (p *Pass) removeAssign(a ast.Assn) *diag.Fix {
+	i := p.tokenAt(a.Pos.Offset)
+	if i < 1 || p.tokens[i-1].Type != tokID {
+		return nil
+	}
+	// From the variable to the expression, keeping comments and line breaks after the "="
+	end := a.Pos.Offset + 1
+	for end < len(p.Src) && (p.Src[end] == ' ' || p.Src[end] == '\t') {
+		end++
+	}
+	return &diag.Fix{
+		Message: "Remove the assignment to " + string(a.V),
+		Edits:   []diag.Edit{{Span: diag.Range(p.Src, p.tokens[i-1].Pos.Offset, end)}},
+	}
+}
+
+// codeStart returns the offset of the code of the program following its function declarations
+func (p *Pass) codeStart() int {
+	if len(p.Prog.Funcs) == 0 {
+		return 0
+	}
+	return p.funcEnd(p.Prog.Funcs[len(p.Prog.Funcs)-1].Pos.Offset)
+}
+
+// funcEnd returns the offset following the closing brace of the function declared at offset
+func (p *Pass) funcEnd(offset int) int {
+	depth := 0
+	for _, tok := range p.tokens {
+		if tok.Pos.Offset < offset {
+			continue
+		}
+		switch tok.Type {
+		case tokLBrace:
+			depth++
+		case tokRBrace:
+			depth--
+			if depth == 0 {
+				return tok.Pos.Offset + 1
+			}
+		}
+	}
+	return len(p.Src)
+}
+
+func toPos(tok *token.Token) ast.Pos {
+	return ast.Pos{Offset: tok.Pos.Offset, Line: tok.Pos.Line, Column: tok.Pos.Column}
+}
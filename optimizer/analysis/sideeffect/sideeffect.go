@@ -8,8 +8,9 @@ import (
 )
 
 // Compute takes a CFG of a *normalized* program and computes the in and out sets of variables at each node that
-// are used for side-effects further on. Side-effects include: Used in the return value and used as arguments in a call.
-func Compute(graph *cfg.CFG) (seLiveIn, seLiveOut map[int]util.Set) {
+// are used for side-effects further on. Side-effects include: Used in the return value and used as arguments in a call
+// of a function that isn't one of the pure built-in functions named in pure (see ast.Context.PureFuncs).
+func Compute(graph *cfg.CFG, pure util.Set) (seLiveIn, seLiveOut map[int]util.Set) {
 	exitLabels := make(map[int]struct{})
 	for _, v := range graph.Exits {
 		exitLabels[v.Label] = struct{}{}
@@ -53,9 +54,14 @@ func Compute(graph *cfg.CFG) (seLiveIn, seLiveOut map[int]util.Set) {
 			}
 		}
 
-		if val, ok := expr.(ast.Call); ok {
+		// In a normalized program calls either appear on their own or as the value of an assignment
+		call, ok := expr.(ast.Call)
+		if assn, isAssn := expr.(ast.Assn); isAssn {
+			call, ok = assn.E.(ast.Call)
+		}
+		if ok && !isPureCall(call, pure) {
 			// A call may contain side-effects, hence all variables used as arguments are side-effect-live
-			for _, arg := range val.Args {
+			for _, arg := range call.Args {
 				gen = gen.Union(ast.UsedVars([]ast.Expr{arg}))
 			}
 		}
@@ -80,3 +86,10 @@ func Compute(graph *cfg.CFG) (seLiveIn, seLiveOut map[int]util.Set) {
 
 	return seLiveIn, seLiveOut
 }
+
+// isPureCall returns whether call calls one of the pure built-in functions, whose arguments are only
+// side-effect-live if the result is
+func isPureCall(call ast.Call, pure util.Set) bool {
+	fnVar, ok := call.Fn.(ast.Var)
+	return ok && pure.Contains(string(fnVar))
+}
@@ -16,7 +16,7 @@ func Normalize(prog Program) Program {
 	n := new(normalizer)
 	n.reset()
 	n.names = UsedVars(prog.Code)
-	code := n.compileStmt(prog.Code)
+	code := n.block(prog.Code)
 
 	funcs := make([]FuncDecl, len(prog.Funcs))
 	for i := range prog.Funcs {
@@ -24,176 +24,256 @@ func Normalize(prog Program) Program {
 		n.names = UsedVars(prog.Funcs[i].Code)
 		funcs[i] = FuncDecl{
 			Params:     prog.Funcs[i].Params,
-			Code:       n.compileStmt(prog.Funcs[i].Code),
+			Code:       n.block(prog.Funcs[i].Code),
 			Identifier: prog.Funcs[i].Identifier,
+			Doc:        prog.Funcs[i].Doc,
+			Pos:        prog.Funcs[i].Pos,
 		}
 	}
 	return Program{Funcs: funcs, Code: code}
 }
 
-// returns list of expressions that are equivalent to root-level expression e
-func (n *normalizer) compileStmt(_e Expr) (res []Expr) {
-	res = []Expr{}
-	switch e := _e.(type) {
-	case Program:
-		panic("not impl")
-	case Block:
-		for _, subExpr := range e {
-			res = append(res, n.compileStmt(subExpr)...)
-		}
-	case Assn:
-		code, loc := n.compileExpr(e.E, 1)
-		res = code
-		res = append(res, Assn{V: e.V, E: loc})
-	case Var:
-		res = []Expr{e}
-	case Val:
-		res = []Expr{e}
-	case Arg:
-		res = []Expr{e}
-	case Index:
-		codeSrc, locSrc := n.compileExpr(e.Source, 0)
-		codeI, locI := n.compileExpr(e.I, 0)
-		res = codeSrc
-		res = append(res, codeI...)
-		res = append(res, Index{Source: locSrc, I: locI})
-	case BinOp:
-		codeLhs, locLhs := n.compileExpr(e.Lhs, 0)
-		codeRhs, locRhs := n.compileExpr(e.Rhs, 0)
-		res = codeLhs
-		res = append(res, codeRhs...)
-		res = append(res, BinOp{Lhs: locLhs, Rhs: locRhs, Op: e.Op})
-	case IfElse: // TODO: currently short-circuiting is broken
-		codeCond, locCond := n.compileExpr(e.Cond, 0)
-		res = append(res, codeCond...)
-
-		newIfElse := IfElse{
-			Cond: locCond,
-			Then: Block(n.compileStmt(e.Then)),
-			Else: Block(n.compileStmt(e.Else)),
+// block returns the statements equivalent to b. Rewrite normalizes the tree bottom-up: an expression whose value is
+// used by its parent is replaced by a Block of the statements computing it followed by its value, a primitive
+// expression or an operation on primitive expressions, and the statements of a Block are flattened.
+func (n *normalizer) block(b Block) Block {
+	// The right-hand sides of && and || are only evaluated if they are needed, which becomes explicit control flow
+	// before their code is moved in front of the operation
+	b = Rewrite(b, func(c *Cursor) Expr {
+		if be, ok := c.Expr.(BinOp); ok && !inLambda(c) {
+			if ie, ok := shortCircuit(be); ok {
+				return ie
+			}
 		}
-		res = append(res, newIfElse)
-	case While: // TODO: this is a tricky one, I believe...
-		// Need to put excess condition code _before_ loop but also _append to the end_ of the body to keep semantics
-		codeCond, locCond := n.compileExpr(e.Cond, 0)
-		res = append(res, codeCond...)
+		return c.Expr
+	}).(Block)
+	return Rewrite(b, n.rewrite).(Block)
+}
 
-		newWhile := While{
-			Cond: locCond,
-			Body: Block(append(n.compileStmt(e.Body), codeCond...)),
-		}
-		res = append(res, newWhile)
-	case Call:
-		var newArgs CallArgs
-		for _, argE := range e.Args {
-			code, loc := n.compileExpr(argE, 0)
-			res = append(res, code...)
-			newArgs = append(newArgs, loc)
-		}
-		res = append(res, Call{Fn: e.Fn, Args: newArgs})
+func (n *normalizer) rewrite(c *Cursor) Expr {
+	if inLambda(c) {
+		// The value of a lambda is its source code, hence it's kept as is
+		return c.Expr
 	}
-	return
-}
+	// An else-if is the statement of the else branch
+	_, inBlock := c.Parent().(Block)
+	stmt := inBlock || c.Name == "Else"
 
-// returns expression that is equivalent to expression e when used after evaluating returned list
-// maxDepth allows forcing of primitive expressions, e.g. recursively calling compileExpr with maxDepth = 0 for "a + b"
-// (a + b)["2"] -> code = [__a = a + b], loc = __a["2"]
-func (n *normalizer) compileExpr(_e Expr, maxDepth int) (code []Expr, loc Expr) {
-	code = []Expr{}
-	switch e := _e.(type) {
-	case Program:
-		panic("Program not subexpr")
+	switch e := c.Expr.(type) {
 	case Block:
-		panic("Block not subexpr")
+		var stmts Block
+		for _, s := range e {
+			if b, ok := s.(Block); ok {
+				stmts = append(stmts, b...)
+			} else {
+				stmts = append(stmts, s)
+			}
+		}
+		if stmts == nil {
+			stmts = Block{}
+		}
+		return stmts
 	case Assn:
-		loc = e.V
-		code = n.compileStmt(e)
-	case Var:
-		loc = e
-	case Val:
-		loc = e
-	case Arg:
-		loc = e
-	case Index:
-		if maxDepth == 0 {
-			v := Var(n.genName())
-			code = n.compileStmt(Assn{V: v, E: e})
-			loc = v
-		} else if maxDepth == 1 {
-			codeSrc, locSrc := n.compileExpr(e.Source, 0)
-			codeI, locI := n.compileExpr(e.I, 0)
-			code = append(code, codeSrc...)
-			code = append(code, codeI...)
-			loc = Index{Source: locSrc, I: locI}
-		} else {
-			panic("maxDepth > 1")
+		code, loc := split(e.E)
+		e.E = loc
+		if stmt {
+			return join(code, e)
 		}
+		return join(append(code, e), e.V)
 	case BinOp:
-		if maxDepth == 0 {
+		code, locs := n.operands(e.Lhs, e.Rhs)
+		e.Lhs, e.Rhs = locs[0], locs[1]
+		return join(code, e)
+	case Index:
+		code, locs := n.operands(e.Source, e.I)
+		e.Source, e.I = locs[0], locs[1]
+		return join(code, e)
+	case Call:
+		// Names of functions are kept, since they don't refer to the variable of the same name. Other values are
+		// copied to a temp, which no function is named after.
+		var code []Expr
+		switch e.Fn.(type) {
+		case Var, Lambda:
+		default:
+			var loc Expr
+			code, loc = split(e.Fn)
 			v := Var(n.genName())
-			code = n.compileStmt(Assn{V: v, E: e})
-			loc = v
-		} else if maxDepth == 1 {
-			codeLhs, locLhs := n.compileExpr(e.Lhs, 0)
-			codeRhs, locRhs := n.compileExpr(e.Rhs, 0)
-			code = append(code, codeLhs...)
-			code = append(code, codeRhs...)
-			loc = BinOp{Lhs: locLhs, Rhs: locRhs, Op: e.Op}
-		} else {
-			panic("maxDepth > 1")
+			code, e.Fn = append(code, Assn{V: v, E: loc}), v
 		}
-	// Always compile control flow to root-level expressions
+		argsCode, args := n.operands(e.Args...)
+		e.Args = CallArgs(args)
+		return join(append(code, argsCode...), e)
 	case IfElse:
+		code, cond := n.operand(e.Cond)
+		e.Cond, e.Else = cond, asBlock(e.Else)
+		if stmt {
+			return join(code, e)
+		}
+		valueCode, v := n.value(e)
+		return join(append(code, valueCode...), v)
+	case While:
+		code, cond := n.operand(e.Cond)
+		body := asBlock(e.Body)
+		e.Cond = cond
+		if !stmt {
+			// The value is assigned by the body, and is "" if the body is never evaluated
+			v := Var(n.genName())
+			e.Body = append(n.assignLast(body, v), code...)
+			return join(append(code, Assn{V: v, E: Val("")}, e), v)
+		}
+		if len(code) == 0 {
+			e.Body = body
+			return e
+		}
+		// The condition code is repeated at the end of the body. The value of the loop is that of the last iteration
+		// of its body, not of the condition code.
 		v := Var(n.genName())
+		e.Body = append(append(n.assignLast(body, v), code...), v)
+		return join(code, e)
+	}
+	return c.Expr
+}
 
-		then := e.Then.(Block)
-		newThen := make(Block, len(then))
-		copy(newThen, then)
-		lastThen := len(then) - 1
-		newThen[lastThen] = Assn{V: v, E: newThen[lastThen]}
+// operand returns the statements computing the rewritten expression e and a primitive expression holding its value
+func (n *normalizer) operand(e Expr) (code []Expr, loc Expr) {
+	code, loc = split(e)
+	if !isPrimitive(loc) {
+		v := Var(n.genName())
+		code = append(code, Assn{V: v, E: loc})
+		loc = v
+	}
+	return code, loc
+}
 
-		eelse := e.Else.(Block)
-		newElse := make(Block, len(eelse))
-		copy(newElse, eelse)
-		lastElse := len(eelse) - 1
-		newElse[lastElse] = Assn{V: v, E: newElse[lastElse]}
+// operands is operand for the operands of an operation, which are evaluated in order. Variables are copied if code of
+// later operands might assign them.
+func (n *normalizer) operands(es ...Expr) (code []Expr, locs []Expr) {
+	codes := make([][]Expr, len(es))
+	locs = make([]Expr, len(es))
+	later := false
+	for i := len(es) - 1; i >= 0; i-- {
+		codes[i], locs[i] = n.operand(es[i])
+		if _, ok := locs[i].(Var); ok && later {
+			v := Var(n.genName())
+			codes[i] = append(codes[i], Assn{V: v, E: locs[i]})
+			locs[i] = v
+		}
+		later = later || len(codes[i]) > 0
+	}
+	for _, c := range codes {
+		code = append(code, c...)
+	}
+	return code, locs
+}
 
-		newIfElse := IfElse{Cond: e.Cond, Then: newThen, Else: newElse}
-		loc = v
-		code = n.compileStmt(newIfElse)
+// value returns the statements computing the value of the normalized statement s and the expression holding it
+func (n *normalizer) value(s Expr) (code []Expr, loc Expr) {
+	switch s := s.(type) {
+	case Assn:
+		return []Expr{s}, s.V
+	case IfElse:
+		v := Var(n.genName())
+		s.Then = n.assignLast(asBlock(s.Then), v)
+		s.Else = n.assignLast(asBlock(s.Else), v)
+		return []Expr{s}, v
 	case While:
 		v := Var(n.genName())
+		s.Body = n.assignLast(asBlock(s.Body), v)
+		return []Expr{Assn{V: v, E: Val("")}, s}, v
+	}
+	return nil, s
+}
+
+// assignLast returns the normalized statements b, but assigning the value of the last one to v
+func (n *normalizer) assignLast(b Block, v Var) Block {
+	if len(b) == 0 {
+		return Block{Assn{V: v, E: Val("")}}
+	}
+	code, loc := n.value(b[len(b)-1])
+	res := append(append(Block{}, b[:len(b)-1]...), code...)
+	return append(res, Assn{V: v, E: loc})
+}
 
-		body := e.Body.(Block)
-		newBody := make(Block, len(body))
-		copy(newBody, body)
-		lastBody := len(body) - 1
-		newBody[lastBody] = Assn{V: v, E: body[lastBody]}
+// split returns the statements and the value of a rewritten expression, see block
+func split(e Expr) (code []Expr, loc Expr) {
+	if b, ok := e.(Block); ok && len(b) > 0 {
+		return append([]Expr(nil), b[:len(b)-1]...), b[len(b)-1]
+	}
+	return nil, e
+}
 
-		// Because the v might not be initialized if the while loop never executes, we need to initialize it before the while loop
-		init := Assn{V: v, E: Val("")}
-		code = []Expr{init}
+// join returns the rewritten expression of the value loc computed by code, see block
+func join(code []Expr, loc Expr) Expr {
+	if len(code) == 0 {
+		return loc
+	}
+	return append(Block(code), loc)
+}
 
-		loc = v
-		code = append(code, n.compileStmt(While{Cond: e.Cond, Body: newBody})...)
-	case Call:
-		if maxDepth == 0 {
-			v := Var(n.genName())
-			code = n.compileStmt(Assn{V: v, E: e})
-			loc = v
-		} else if maxDepth == 1 {
-			var newArgs CallArgs
-			for _, argE := range e.Args {
-				codeArg, locArg := n.compileExpr(argE, 0)
-				code = append(code, codeArg...)
-				newArgs = append(newArgs, locArg)
-			}
-			loc = Call{Fn: e.Fn, Args: newArgs}
-		} else {
-			panic("maxDepth > 1")
+// asBlock returns e as the statements of a branch or a loop body, which programs built by hand may not have put in
+// a Block
+func asBlock(e Expr) Block {
+	if b, ok := e.(Block); ok {
+		return b
+	}
+	return Block{e}
+}
+
+// isPrimitive returns whether e has no subexpressions that need evaluating
+func isPrimitive(e Expr) bool {
+	switch e.(type) {
+	case Var, Val, Arg, Lambda, Bad:
+		return true
+	}
+	return false
+}
+
+// inLambda returns whether the expression of c is part of the code of a lambda
+func inLambda(c *Cursor) bool {
+	for _, e := range c.Path {
+		if _, ok := e.(Lambda); ok {
+			return true
 		}
 	}
-	return
+	return false
+}
+
+// shortCircuit returns the if-expression equivalent to a && or || whose right-hand side may be skipped, and
+// would behave differently if it wasn't
+func shortCircuit(e BinOp) (IfElse, bool) {
+	if e.Op != AndOp && e.Op != OrOp || isSimple(e.Rhs) {
+		return IfElse{}, false
+	}
+	if lhs, ok := e.Lhs.(Val); ok && BoolOf(lhs) == (e.Op == AndOp) {
+		// The right-hand side is always evaluated
+		return IfElse{}, false
+	}
+	// "true" && b and "false" || b convert b to a boolean, without evaluating anything else
+	if e.Op == AndOp {
+		return IfElse{
+			Cond: e.Lhs,
+			Then: Block{BinOp{Lhs: Val("true"), Rhs: e.Rhs, Op: AndOp}},
+			Else: Block{Val("false")},
+		}, true
+	}
+	return IfElse{
+		Cond: e.Lhs,
+		Then: Block{Val("true")},
+		Else: Block{BinOp{Lhs: Val("false"), Rhs: e.Rhs, Op: OrOp}},
+	}, true
+}
+
+// isSimple returns whether evaluating e neither has side effects nor might fail to terminate
+func isSimple(e Expr) bool {
+	switch e := e.(type) {
+	case Var, Val, Arg:
+		return true
+	case BinOp:
+		return isSimple(e.Lhs) && isSimple(e.Rhs)
+	case Index:
+		return isSimple(e.Source) && isSimple(e.I)
+	}
+	return false
 }
 
 func (n *normalizer) reset() {
@@ -211,67 +291,3 @@ func (n *normalizer) genName() string {
 	n.names["__temp"+lastNum] = struct{}{}
 	return "__temp" + lastNum
 }
-
-//func succ(s string) string {
-//	sRev := reverse(s)
-//
-//	if sRev == "" {
-//		return "a"
-//	}
-//
-//	if sRev[0] == 'z' {
-//		return reverse("a" + reverse(succ(reverse(sRev[1:]))))
-//	}
-//
-//	res := string(sRev[0]+1) + sRev[1:]
-//	return reverse(res)
-//}
-//
-//func reverse(s string) string {
-//	var sRev string
-//	for i := len(s) - 1; i >= 0; i-- {
-//		sRev += string(s[i])
-//	}
-//	return sRev
-//}
-
-//func max(a, b int) int {
-//	if a > b {
-//		return a
-//	}
-//	return b
-//}
-//
-//func primitiveLevel(e Expr) int {
-//	switch val := e.(type) {
-//	case Program:
-//		return primitiveLevel(val.Code)
-//	case Block:
-//		codeMax := 0
-//		for _, e := range val {
-//			codeMax = max(primitiveLevel(e), codeMax)
-//		}
-//		return len(val) + codeMax
-//	case Assn:
-//		return 1 + primitiveLevel(val.E)
-//	case Var:
-//		return 0
-//	case Val:
-//		return 0
-//	case BinOp:
-//		return 1 + max(primitiveLevel(val.Lhs), primitiveLevel(val.Rhs))
-//	case While:
-//		return 1 + max(primitiveLevel(val.Cond), primitiveLevel(val.Body))
-//	case IfElse:
-//		return 1 + max(max(primitiveLevel(val.Cond), primitiveLevel(val.Then)), primitiveLevel(val.Else))
-//	case Call:
-//		argM := 0
-//		for _, e := range val.Args {
-//			argM = max(primitiveLevel(e), argM)
-//		}
-//		return 1 + argM
-//	case Index:
-//		return 1 + max(primitiveLevel(val.Source), primitiveLevel(val.I))
-//	}
-//	return 0
-//}
@@ -0,0 +1,61 @@
+package optimizer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/optimizer"
+)
+
+func TestNormalizeWhileBodies(t *testing.T) {
+	// The condition assigns, so its code is repeated after the body
+	x := ast.Var("x")
+	cond := ast.BinOp{Lhs: ast.Assn{V: x, E: ast.BinOp{Lhs: x, Rhs: ast.Val("a"), Op: ast.ConcatOp}},
+		Rhs: ast.Val("aaa"), Op: ast.NotEqualsOp}
+	tests := []struct {
+		body ast.Expr
+		want string
+	}{
+		{ast.Block{ast.Val("c"), x}, "aa"},
+		{ast.Block{}, ""},
+		{ast.Val("b"), "b"},
+		{x, "aa"},
+	}
+	for _, tt := range tests {
+		prog := ast.Program{Code: ast.Block{ast.Assn{V: x, E: ast.Val("")}, ast.While{Cond: cond, Body: tt.body}}}
+		norm := optimizer.Normalize(prog)
+		got, err := stringlang.EvalContext(context.Background(), stringlang.NewContext(nil, nil), norm)
+		if err != nil || got != tt.want {
+			t.Errorf("evaluating %q normalized to %q: got %q, %v, want %q", prog.String(), norm.String(), got, err,
+				tt.want)
+		}
+	}
+}
+
+func TestNormalizeEvaluationOrder(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+	}{
+		// Operands are evaluated before the assignments of later ones
+		{`x = "a"; x + (x = "b")`, "ab"},
+		{`x = "a"; x[(x = "0")] + x`, "a0"},
+		// The value of a computed function isn't the function of the same name
+		{`fun f() { "f" } f = fun() { "lambda" }; (f = f)()`, "lambda"},
+		{`k = fun(x) { fun(y) { x } }; k("a")("b")`, "a"},
+		{`x = if ((y = "") == "") { "a" } else if (y) { "b" } else { "c" } + y; x`, "a"},
+	}
+	for _, tt := range tests {
+		e, err := stringlang.Parse([]byte(tt.src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		norm := optimizer.Normalize(e.(ast.Program))
+		got, err := stringlang.EvalContext(context.Background(), stringlang.NewContext(nil, nil), norm)
+		if err != nil || got != tt.want {
+			t.Errorf("evaluating %q normalized to %q: got %q, %v, want %q", tt.src, norm.String(), got, err, tt.want)
+		}
+	}
+}
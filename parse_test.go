@@ -0,0 +1,96 @@
+package stringlang_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang"
+	"github.com/skius/stringlang/ast"
+)
+
+// nested returns a program nesting n concatenations in parentheses
+func nested(n int) string {
+	return strings.Repeat(`("a" + `, n) + `"b"` + strings.Repeat(")", n)
+}
+
+func TestParseLimited(t *testing.T) {
+	tests := []struct {
+		src      string
+		maxDepth int
+		tooDeep  bool
+	}{
+		{`"a"`, 0, true},
+		{`"a"`, 3, false},
+		{`"a"`, -1, false},
+		{nested(10), 10, true},
+		{nested(10), 30, false},
+		{nested(10000), -1, false},
+		{`fun f() { ` + nested(10) + ` } f()`, 10, true},
+		{`x = fun() { ` + nested(10) + ` }; x()`, 10, true},
+	}
+	for _, tt := range tests {
+		_, err := stringlang.ParseLimited([]byte(tt.src), tt.maxDepth)
+		if tt.tooDeep && err != stringlang.ErrNestingTooDeep {
+			t.Errorf("parsing %.40q with limit %d: got error %v, want %v", tt.src, tt.maxDepth, err,
+				stringlang.ErrNestingTooDeep)
+		}
+		if !tt.tooDeep && err != nil {
+			t.Errorf("parsing %.40q with limit %d: got error %v", tt.src, tt.maxDepth, err)
+		}
+	}
+
+	// The default limit protects the parser and evaluation from untrusted source
+	if _, err := stringlang.Parse([]byte(nested(100000))); err != stringlang.ErrNestingTooDeep {
+		t.Errorf("parsing a deeply nested program: got error %v, want %v", err, stringlang.ErrNestingTooDeep)
+	}
+}
+
+func TestParseRecover(t *testing.T) {
+	tests := []struct {
+		src  string
+		want string
+		bads []int // Offsets of the Bad statements, which are those of the syntax errors
+	}{
+		{`x = "a"; y = x`, "x = \"a\";\ny = x", nil},
+		{`x = ); y = "a"`, "<syntax error>;\ny = \"a\"", []int{4}},
+		{`a = "1"; b = (; c = "3"; d = )`, "a = \"1\";\n<syntax error>;\nc = \"3\";\n<syntax error>", []int{14, 29}},
+		{`x = `, "<syntax error>", []int{4}},
+		{`}`, "<syntax error>", []int{0}},
+		{`fun f() { x = ; "a" } f()`, "fun f() {\n\t<syntax error>;\n\t\"a\"\n}\nf()", []int{14}},
+		{`while (x) { y = ; z }; w`, "while (x) {\n\t<syntax error>;\n\tz\n};\nw", []int{16}},
+		{`if (a) { b = ) } else { c }; d = f(,); e`,
+			"if (a) {\n\t<syntax error>\n} else {\n\tc\n};\n<syntax error>;\ne", []int{13, 35}},
+		{`x = fun() { ) }; y`, "x = fun() {\n\t<syntax error>\n};\ny", []int{12}},
+		{`fun f( { "a" }`, "<syntax error>", []int{7}},
+	}
+	for _, tt := range tests {
+		e, err := stringlang.ParseRecover([]byte(tt.src))
+		if e == nil {
+			t.Errorf("parsing %q: got no program, %v", tt.src, err)
+			continue
+		}
+		if got := strings.TrimSpace(e.String()); got != tt.want {
+			t.Errorf("parsing %q: got\n%s\nwant\n%s", tt.src, got, tt.want)
+		}
+
+		var bads, errPos []int
+		ast.Inspect(e, func(e ast.Expr) bool {
+			if b, ok := e.(ast.Bad); ok {
+				bads = append(bads, b.Pos.Offset)
+			}
+			return true
+		})
+		errs, _ := err.(stringlang.SyntaxErrors)
+		if (err == nil) != (len(tt.bads) == 0) || err != nil && errs == nil {
+			t.Errorf("parsing %q: got error %v", tt.src, err)
+		}
+		for _, serr := range errs {
+			errPos = append(errPos, serr.Pos.Offset)
+		}
+		if !reflect.DeepEqual(bads, tt.bads) || !reflect.DeepEqual(errPos, tt.bads) {
+			t.Errorf("parsing %q: got Bad statements at %v and errors at %v, want both at %v", tt.src, bads, errPos,
+				tt.bads)
+		}
+	}
+}
@@ -3,28 +3,97 @@ package stringlang
 import (
 	"errors"
 	"github.com/skius/stringlang/ast"
-	"github.com/skius/stringlang/internal/frontend/lexer"
-	"github.com/skius/stringlang/internal/frontend/parser"
+	"github.com/skius/stringlang/internal/syntax"
 )
 
 type Expr = ast.Expr
 type Val = ast.Val
 type Var = ast.Var
 type Context = ast.Context
+type Builtin = ast.Builtin
+type BuiltinFunc = ast.BuiltinFunc
+type CallContext = ast.CallContext
 
+// NewContext returns a Context with the given arguments and built-in functions, see ast.PlainBuiltin
 func NewContext(args []string, funcs map[string]func([]string) string) *Context {
 	return ast.NewContext(args, funcs, Parse)
 }
 
+// NewContextBuiltins returns a Context with the given arguments and built-in functions
+func NewContextBuiltins(args []string, builtins ...Builtin) *Context {
+	ctx := ast.NewContextBuiltins(args, nil, Parse)
+	for _, b := range builtins {
+		ctx.AddBuiltin(b)
+	}
+	return ctx
+}
+
+// DefaultMaxNestingDepth is the nesting depth of expressions Parse accepts. Evaluating and printing programs recurses
+// once per level, so bounding it protects hosts from stack overflows caused by untrusted source.
+const DefaultMaxNestingDepth = 500
+
+// ErrNestingTooDeep is returned when the parsed program is nested deeper than allowed
+var ErrNestingTooDeep = errors.New("program is nested too deeply")
+
+// Parse parses body, rejecting programs nested deeper than DefaultMaxNestingDepth. Syntax errors are reported as
+// *SyntaxError.
 func Parse(body []byte) (ast.Expr, error) {
-	l := lexer.NewLexer(body)
-	s, err := parser.NewParser().Parse(l)
+	return ParseLimited(body, DefaultMaxNestingDepth)
+}
+
+// ParseLimited parses body, rejecting programs nested deeper than maxDepth. A negative maxDepth disables the limit, which
+// is only safe for trusted source code: deeply nested programs may crash the host with a stack overflow. Syntax errors
+// are reported as *SyntaxError, the first one if there are several.
+func ParseLimited(body []byte, maxDepth int) (ast.Expr, error) {
+	e, err := ParseRecoverLimited(body, maxDepth)
+	if errs, ok := err.(SyntaxErrors); ok {
+		return nil, errs[0]
+	}
 	if err != nil {
 		return nil, err
 	}
-	e, ok := s.(ast.Expr)
-	if !ok {
-		return nil, errors.New("couldn't cast parsing result to Expr")
+	return e, nil
+}
+
+// ParseRecover parses body like Parse, but continues after syntax errors at the end of the statement containing them,
+// i.e. the next ";" or "}" which isn't part of a block within the statement. If there are syntax errors, it returns
+// them as SyntaxErrors along with the partial program, in which an ast.Bad replaces every statement that didn't parse.
+// The program is nil if there are other errors, or the source code couldn't be parsed at all.
+func ParseRecover(body []byte) (ast.Expr, error) {
+	return ParseRecoverLimited(body, DefaultMaxNestingDepth)
+}
+
+// ParseRecoverLimited is ParseRecover, rejecting programs nested deeper than maxDepth like ParseLimited
+func ParseRecoverLimited(body []byte, maxDepth int) (ast.Expr, error) {
+	e, perrs, err := syntax.Parse(body, maxDepth)
+	if err == syntax.ErrTooDeep {
+		return nil, ErrNestingTooDeep
+	}
+	var errs SyntaxErrors
+	if len(perrs) > 0 {
+		// Scanned once for all errors, which are described by the tokens preceding them
+		tokens := scanTokens(body)
+		for _, perr := range perrs {
+			errs = append(errs, newSyntaxError(tokens, perr))
+		}
+	}
+	if err != nil || e == nil {
+		if len(errs) == 0 {
+			return nil, errors.New("couldn't parse program")
+		}
+		return nil, errs
+	}
+
+	if ast.ExceedsDepth(e, maxDepth) {
+		return nil, ErrNestingTooDeep
+	}
+	if prog, ok := e.(ast.Program); ok {
+		for i := range prog.Funcs {
+			prog.Funcs[i].Doc = ast.DocComment(body, prog.Funcs[i].Pos.Offset)
+		}
+	}
+	if len(errs) > 0 {
+		return e, errs
 	}
 	return e, nil
 }
@@ -0,0 +1,220 @@
+package stringlang
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/skius/stringlang/ast"
+	"github.com/skius/stringlang/internal/syntax"
+	parseErrors "github.com/skius/stringlang/internal/syntax/errors"
+	"github.com/skius/stringlang/internal/syntax/token"
+)
+
+// SyntaxError is the error Parse returns for source code that doesn't parse. Its message describes the tokens in words,
+// and for the pitfalls listed in the caveats of the README, Hint explains how to fix the program.
+type SyntaxError struct {
+	Pos     ast.Pos // Position of the unexpected token
+	End     int     // Offset of the end of the unexpected token
+	Message string  // What was expected and found instead, e.g. `expected an expression, found ";"`
+	Hint    string  // How to fix the program, empty if unknown
+	// Incomplete is whether the source code ended unexpectedly, hence could become valid by appending to it
+	Incomplete bool
+
+	err *parseErrors.Error
+}
+
+func (e *SyntaxError) Error() string {
+	return e.Pos.String() + ": error: " + e.Message
+}
+
+func (e *SyntaxError) Unwrap() error {
+	return e.err
+}
+
+// SyntaxErrors are all syntax errors of source code, in the order of their positions
+type SyntaxErrors []*SyntaxError
+
+func (e SyntaxErrors) Error() string {
+	switch len(e) {
+	case 0:
+		return "no syntax errors"
+	case 1:
+		return e[0].Error()
+	case 2:
+		return e[0].Error() + " (and 1 more error)"
+	}
+	return e[0].Error() + " (and " + strconv.Itoa(len(e)-1) + " more errors)"
+}
+
+// Types of tokens
+var (
+	tokEOF       = token.TokMap.Type("$")
+	tokID        = token.TokMap.Type("id")
+	tokString    = token.TokMap.Type("string_lit")
+	tokInt       = token.TokMap.Type("int_lit")
+	tokSemicolon = token.TokMap.Type(";")
+	tokAssign    = token.TokMap.Type("=")
+	tokLBrace    = token.TokMap.Type("{")
+	tokRBrace    = token.TokMap.Type("}")
+)
+
+// Groups of expected tokens described by a single phrase
+var tokenGroups = []struct {
+	tokens []string
+	phrase string
+}{
+	{[]string{"fun", "id", "(", "string_lit", "%", "if", "while"}, "an expression"},
+	{[]string{"||", "&&", "!=", "==", "+"}, "an operator"},
+}
+
+// Phrases describing expected tokens, others are quoted
+var tokenPhrases = map[string]string{
+	"$":          "the end of the program",
+	"id":         "a name",
+	"string_lit": "a string",
+	"int_lit":    "a number",
+	"%":          "an argument like %0",
+}
+
+// scanTokens returns the tokens of src, without the end of the program
+func scanTokens(src []byte) []*token.Token {
+	var tokens []*token.Token
+	l := syntax.NewScanner(src)
+	for t := l.Scan(); t.Type != tokEOF; t = l.Scan() {
+		tokens = append(tokens, t)
+	}
+	return tokens
+}
+
+// newSyntaxError describes err, which the parser returned for the source code consisting of tokens
+func newSyntaxError(tokens []*token.Token, err *parseErrors.Error) *SyntaxError {
+	tok := err.ErrorToken
+	e := &SyntaxError{
+		Pos:        ast.Pos{Offset: tok.Pos.Offset, Line: tok.Pos.Line, Column: tok.Pos.Column},
+		End:        tok.Pos.Offset + len(tok.Lit),
+		Incomplete: tok.Type == tokEOF || tok.Type == token.INVALID && strings.HasPrefix(string(tok.Lit), `"`),
+		err:        err,
+	}
+	switch {
+	case err.Err != nil:
+		e.Message = err.Err.Error()
+	case len(err.ExpectedTokens) == 0:
+		e.Message = "unexpected " + describeToken(tok)
+	default:
+		e.Message = "expected " + describeExpected(err.ExpectedTokens) + ", found " + describeToken(tok)
+	}
+
+	// The token before the unexpected one tells most pitfalls apart
+	var prev *token.Token
+	if i := sort.Search(len(tokens), func(i int) bool { return tokens[i].Pos.Offset >= tok.Pos.Offset }); i > 0 {
+		prev = tokens[i-1]
+	}
+	switch {
+	case tok.Type == tokAssign && prev != nil && prev.Type == tokID:
+		e.Hint = "an assignment within an expression needs parentheses, e.g. \"a\" + (" + string(prev.Lit) +
+			" = \"b\")"
+	case (tok.Type == tokRBrace || tok.Type == tokEOF) && prev != nil && prev.Type == tokSemicolon:
+		e.Hint = "\";\" separates expressions, the last expression of a block isn't followed by one, remove the " +
+			"\";\" before " + describeToken(tok)
+	case tok.Type == tokRBrace && prev != nil && prev.Type == tokLBrace:
+		e.Hint = "a block can't be empty, write \"\" for a block that does nothing"
+	case prev != nil && prev.Pos.Line < tok.Pos.Line && tok.Type != tokEOF && contains(err.ExpectedTokens, ";"):
+		e.Hint = "expressions are separated by \";\", is one missing at the end of line " + strconv.Itoa(prev.Pos.Line) + "?"
+	case len(err.ExpectedTokens) == 1 && err.ExpectedTokens[0] == "else":
+		e.Hint = "every if needs an else branch, write else { \"\" } if there's nothing to do otherwise"
+	}
+	return e
+}
+
+// describeExpected lists the expected tokens in words
+func describeExpected(expected []string) string {
+	remaining := make(map[string]bool, len(expected))
+	for _, t := range expected {
+		remaining[t] = true
+	}
+	var phrases []string
+	for _, t := range expected {
+		if !remaining[t] {
+			continue
+		}
+		phrase := ""
+		for _, g := range tokenGroups {
+			if containsAll(remaining, g.tokens) && contains(g.tokens, t) {
+				for _, member := range g.tokens {
+					delete(remaining, member)
+				}
+				phrase = g.phrase
+				break
+			}
+		}
+		if phrase == "" {
+			delete(remaining, t)
+			if phrase = tokenPhrases[t]; phrase == "" {
+				phrase = strconv.Quote(t)
+			}
+		}
+		phrases = append(phrases, phrase)
+	}
+	// Phrases in words before quoted tokens, the end of the program last
+	rank := func(phrase string) int {
+		switch {
+		case phrase == tokenPhrases["$"]:
+			return 2
+		case strings.HasPrefix(phrase, `"`):
+			return 1
+		}
+		return 0
+	}
+	sort.SliceStable(phrases, func(i, j int) bool { return rank(phrases[i]) < rank(phrases[j]) })
+
+	switch len(phrases) {
+	case 1:
+		return phrases[0]
+	case 2:
+		return phrases[0] + " or " + phrases[1]
+	}
+	return strings.Join(phrases[:len(phrases)-1], ", ") + " or " + phrases[len(phrases)-1]
+}
+
+// describeToken describes the unexpected token in words
+func describeToken(tok *token.Token) string {
+	lit := string(tok.Lit)
+	if runes := []rune(lit); len(runes) > 20 {
+		lit = string(runes[:17]) + "..."
+	}
+	switch tok.Type {
+	case tokEOF:
+		return "the end of the program"
+	case token.INVALID:
+		if strings.HasPrefix(lit, `"`) {
+			return "a string missing its closing \""
+		}
+		return "the invalid character " + strconv.Quote(lit)
+	case tokID:
+		return "the name " + lit
+	case tokString:
+		return "the string " + lit
+	case tokInt:
+		return "the number " + lit
+	}
+	return strconv.Quote(lit)
+}
+
+func containsAll(set map[string]bool, els []string) bool {
+	for _, el := range els {
+		if !set[el] {
+			return false
+		}
+	}
+	return true
+}
+
+func contains(els []string, el string) bool {
+	for _, e := range els {
+		if e == el {
+			return true
+		}
+	}
+	return false
+}
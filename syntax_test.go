@@ -0,0 +1,60 @@
+package stringlang_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/skius/stringlang"
+)
+
+func TestSyntaxErrorHints(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		hint string // A part of the hint
+	}{
+		{"assignment in an expression", `"a" + x = "b"`, `"a" + (x = "b")`},
+		{"trailing ; in a block", `if ("a") { "b"; } else { "c" }`, `remove the ";" before "}"`},
+		{"trailing ; at the end", `x = "a";`, `remove the ";" before the end of the program`},
+		{"empty block", `fun f() { } f()`, `a block can't be empty`},
+		{"missing ;", "x = \"a\"\ny = \"b\"", `is one missing at the end of line 1?`},
+		{"missing else", `if ("a") { "b" }`, `every if needs an else branch`},
+		{"no hint", `x = )`, ``},
+	}
+	for _, tt := range tests {
+		_, err := stringlang.Parse([]byte(tt.src))
+		serr, ok := err.(*stringlang.SyntaxError)
+		if !ok {
+			t.Errorf("%s: got error %v, want a SyntaxError", tt.name, err)
+			continue
+		}
+		if tt.hint == "" && serr.Hint != "" || !strings.Contains(serr.Hint, tt.hint) {
+			t.Errorf("%s: got hint %q, want one containing %q", tt.name, serr.Hint, tt.hint)
+		}
+		if serr.Incomplete != (tt.name == "trailing ; at the end" || tt.name == "missing else") {
+			t.Errorf("%s: got Incomplete %v", tt.name, serr.Incomplete)
+		}
+	}
+}
+
+func TestSyntaxErrorUnterminatedString(t *testing.T) {
+	_, err := stringlang.Parse([]byte(`x = "abc`))
+	serr, ok := err.(*stringlang.SyntaxError)
+	if !ok || !serr.Incomplete || !strings.Contains(serr.Message, `a string missing its closing "`) {
+		t.Errorf("got error %#v, want an incomplete SyntaxError about the string", err)
+	}
+}
+
+func TestSyntaxErrorsHints(t *testing.T) {
+	// Every error gets the hint of the tokens preceding it
+	_, err := stringlang.ParseRecover([]byte("fun f() { } a = \"b\" + x = \"c\"; if (a) { \"d\"; } else { \"e\" }"))
+	errs, ok := err.(stringlang.SyntaxErrors)
+	if !ok || len(errs) != 3 {
+		t.Fatalf("got error %v, want 3 SyntaxErrors", err)
+	}
+	for i, hint := range []string{`a block can't be empty`, `(x = "b")`, `remove the ";"`} {
+		if !strings.Contains(errs[i].Hint, hint) {
+			t.Errorf("error %d: got hint %q, want one containing %q", i, errs[i].Hint, hint)
+		}
+	}
+}
@@ -1,82 +1,86 @@
 package stringlang
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
-	"math/rand"
 	"strconv"
 	"time"
+
+	"github.com/skius/stringlang/ast"
 )
 
-func EvalOrTimeout(ctx *Context, expr Expr, timeout time.Duration) (string, error) {
-	exit := ctx.GetExitChannel()
+// EvalContext evaluates expr using ctx and returns its result, or an error if the evaluation panicked or was aborted.
+// The evaluation stops as soon as goCtx is done, and built-in functions get goCtx through their CallContext. No
+// goroutines are started, hence none can be leaked.
+func EvalContext(goCtx context.Context, ctx *Context, expr Expr) (string, error) {
+	result, err := ctx.EvalContext(goCtx, expr)
+	return string(result), err
+}
 
-	resultChan := make(chan string)
-	errChan := make(chan interface{})
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				errChan <- r
-			}
-		}()
-		resultChan <- string(expr.Eval(ctx))
-	}()
+// EvalOrTimeout evaluates expr using ctx, but stops after timeout.
+//
+// Deprecated: Use EvalContext with context.WithTimeout instead.
+func EvalOrTimeout(ctx *Context, expr Expr, timeout time.Duration) (string, error) {
+	goCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
 
-	var result string
-	select {
-	case result = <-resultChan:
-	case err := <-errChan:
-		return "", errors.New(fmt.Sprint(err))
-	case <-time.After(timeout):
-		exit <- 1
+	result, err := EvalContext(goCtx, ctx, expr)
+	if errors.Is(err, context.DeadlineExceeded) {
 		return "", errors.New(fmt.Sprint("Program timed out after ", timeout))
 	}
-	return result, nil
+	return result, err
 }
 
 func ExampleContext(limitStack bool) *Context {
-	rand.Seed(time.Now().UnixNano())
-	funcs := map[string]func([]string) string{
-		"random": func(args []string) string {
+	random := BuiltinFunc{
+		Identifier: "random",
+		Min:        0,
+		Max:        -1,
+		Documentation: "random() returns a random number from 1 to 10, random(n) one from 1 to n, " +
+			"random(a, b, ...) one of its arguments.",
+		Fn: func(cc *CallContext, args []string) (string, error) {
+			rng := cc.Rand()
 			num := len(args)
 			if num == 0 {
-				return strconv.Itoa(rand.Intn(10) + 1)
+				return strconv.Itoa(rng.Intn(10) + 1), nil
 			} else if num == 1 {
 				val, err := strconv.Atoi(args[0])
-				if err == nil {
-					return strconv.Itoa(rand.Intn(val) + 1)
+				if err == nil && val > 0 {
+					return strconv.Itoa(rng.Intn(val) + 1), nil
 				}
 			}
-			return args[rand.Intn(num)]
+			return args[rng.Intn(num)], nil
 		},
-		"length": func(args []string) string {
-			return strconv.Itoa(len(args[0]))
+	}
+	now := BuiltinFunc{
+		Identifier:    "now",
+		Min:           0,
+		Max:           0,
+		Documentation: "now() returns the current time as seconds since the Unix epoch.",
+		Fn: func(cc *CallContext, _ []string) (string, error) {
+			return strconv.FormatInt(cc.Now().Unix(), 10), nil
+		},
+	}
+	length := BuiltinFunc{
+		Identifier:    "length",
+		Min:           1,
+		Max:           1,
+		IsPure:        true,
+		Documentation: "length(s) returns the number of bytes of s.",
+		Fn: func(_ *CallContext, args []string) (string, error) {
+			return strconv.Itoa(len(args[0])), nil
 		},
 	}
 
 	args := make([]string, len(flag.Args()))
 	copy(args, flag.Args())
 
-	ctx := NewContext(args, funcs)
-	// Add special eval function, needs reference to ctx to work
-	// Modifies context, i.e. eval adds support for unhygienic macros
-	// Only works if ctx gets reused, like what REPL is doing
-	// TODO: eval in a function body actually evals using the parent scope. no good.
-	ctx.FunctionMap["eval"] = func(args []string) string {
-		if len(args) == 0 {
-			return ""
-		}
-		src := args[0]
-		expr, err := Parse([]byte(src))
-		if err != nil {
-			return ""
-		}
-		result := expr.Eval(ctx)
-		return string(result)
-	}
+	// eval evaluates in the scope of its caller, i.e. supports unhygienic macros, eval_isolated in a scope of its own
+	ctx := NewContextBuiltins(args, random, now, length, ast.EvalBuiltin(), ast.EvalIsolatedBuiltin())
 	if limitStack {
-		ctx.SetMaxStackSize(100 * 1024 * 1024) // 100MB limit for programs
+		ctx.SetMaxMemory(100 * 1024 * 1024) // 100MB limit for programs
 	}
 
 	return ctx